@@ -15,10 +15,9 @@
 package db
 
 import (
-	"fmt"
-	"os"
 	"time"
 
+	"github.com/manatee-project/manatee/app/api/biz/pkg/config"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
@@ -28,8 +27,7 @@ var DB *gorm.DB
 
 func Init() {
 	var err error
-	mysqlDsn := fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?charset=utf8&parseTime=True&loc=Local", os.Getenv("MYSQL_USERNAME"), os.Getenv("MYSQL_PASSWORD"), os.Getenv("MYSQL_HOST"), os.Getenv("MYSQL_PORT"), os.Getenv("MYSQL_DATABASE"))
-	DB, err = gorm.Open(mysql.Open(mysqlDsn), &gorm.Config{
+	DB, err = gorm.Open(mysql.Open(config.Get().MySQL.DSN()), &gorm.Config{
 		SkipDefaultTransaction: true,
 		PrepareStmt:            true,
 		Logger:                 logger.Default.LogMode(logger.Info),
@@ -50,7 +48,7 @@ func Init() {
 
 	// Auto database schema migration
 	// This has caveat: see https://gorm.io/docs/migration.html
-	err = DB.AutoMigrate(&Job{})
+	err = DB.AutoMigrate(&Job{}, &JobEvent{})
 	if err != nil {
 		panic(err)
 	}
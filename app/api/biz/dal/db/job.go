@@ -26,22 +26,65 @@ type Job struct {
 	ID                      uint64            `gorm:"id" json:"id""`
 	UUID                    string            `gorm:"uuid" json:"uuid"`
 	Creator                 string            `gorm:"creator" json:"creator"`
+	Groups                  []string          `gorm:"serializer:json" json:"groups"` // groups of the creator at submission, for the group quotas
 	JupyterFileName         string            `gorm:"jupyter_file_name" json:"jupyter_file_name"`
 	BuildContextPath        string            `gorm:"build_context_path" json:"build_context_path"`
 	OutputPutSignedUrl      string            `gorm:"output_put_signed_url" json:"output_put_signed_url"`
 	CustomTokenPutSignedUrl string            `gorm:"custom_token_put_signed_url" json:"custom_token_put_signed_url"`
+	ManifestPutSignedUrl    string            `gorm:"manifest_put_signed_url" json:"manifest_put_signed_url"`
+	WorkspaceHash           string            `gorm:"workspace_hash" json:"workspace_hash"`                 // sha256 of the submitted workspace, attested with the output
+	AttestationAudience     string            `gorm:"attestation_audience" json:"attestation_audience"`     // audience the attestation token is requested for
+	AttestationTokenType    string            `gorm:"attestation_token_type" json:"attestation_token_type"` // OIDC or PKI
 	Dockerfile              string            `gorm:"dockerfile" json:"dockerfile"`
 	DockerImage             string            `gorm:"docker_image" json:"docker_image"`
 	DockerImageDigest       string            `gorm:"docker_image_digest" json:"docker_image_digest"`
 	JobStatus               int               `gorm:"job_status" json:"job_status"`
 	InstanceName            string            `gorm:"instance_name" json:"instance_name"`
 	ExtraEnvs               map[string]string `gorm:"serializer:json"`
+	CancelRequested         bool              `gorm:"cancel_requested" json:"cancel_requested"`
+	FailureReason           string            `gorm:"failure_reason" json:"failure_reason"`
+	FailureDetail           string            `gorm:"type:text" json:"failure_detail"`
+	TimeoutSeconds          int64             `gorm:"timeout_seconds" json:"timeout_seconds"`
+	MachineType             string            `gorm:"machine_type" json:"machine_type"`
+	DiskSizeGB              int64             `gorm:"disk_size_gb" json:"disk_size_gb"`
+	ConfidentialType        string            `gorm:"column:confidential_instance_type" json:"confidential_instance_type"`
+	RetryCount              int               `gorm:"retry_count" json:"retry_count"`
+	PermanentErrorCount     int               `gorm:"permanent_error_count" json:"permanent_error_count"`
+	NextAttemptAt           *time.Time        `gorm:"next_attempt_at" json:"next_attempt_at"`
+	Priority                int               `gorm:"priority" json:"priority"`
+	AdmittedAt              *time.Time        `gorm:"admitted_at" json:"admitted_at"` // when the job was admitted within the quotas, its timeout starts then
+	LaunchedAt              *time.Time        `gorm:"launched_at" json:"launched_at"` // when the VM was launched
+	StartedAt               *time.Time        `gorm:"started_at" json:"started_at"`   // when the VM started running, or was launched if it ended before
+	FinishedAt              *time.Time        `gorm:"finished_at" json:"finished_at"` // when the job reached a final status
+	StatusReason            string            `gorm:"-" json:"-"`                     // why JobStatus last changed, persisted in job_events
 }
 
 func (Job) TableName() string {
 	return "jobs"
 }
 
+// VMTime returns how long the VM of the job ran between start and end, a VM still running being counted until now.
+// It is both the usage billed and the usage the creators share fairly.
+func (j *Job) VMTime(start, end, now time.Time) time.Duration {
+	if j.StartedAt == nil {
+		return 0
+	}
+	from, to := *j.StartedAt, now
+	if j.FinishedAt != nil {
+		to = *j.FinishedAt
+	}
+	if from.Before(start) {
+		from = start
+	}
+	if to.After(end) {
+		to = end
+	}
+	if !to.After(from) {
+		return 0
+	}
+	return to.Sub(from)
+}
+
 func CreateJob(job *Job) error {
 	timestamp := time.Now()
 	job.UpdatedAt = timestamp
@@ -53,18 +96,70 @@ func CreateJob(job *Job) error {
 	return nil
 }
 
-func UpdateJob(j *Job) error {
-	result := DB.Model(j).Updates(
-		Job{
-			JobStatus:         j.JobStatus,
-			BuildContextPath:  j.BuildContextPath,
-			DockerImageDigest: j.DockerImageDigest,
-			DockerImage:       j.DockerImage,
-			InstanceName:      j.InstanceName,
-			ExtraEnvs:         j.ExtraEnvs,
+// ErrJobStatusConflict is returned by UpdateJob when the job status was changed by another writer.
+var ErrJobStatusConflict = errors.New("job status was changed concurrently")
+
+// jobUpdateColumns are the columns of a job written by UpdateJob.
+var jobUpdateColumns = []string{
+	"job_status", "build_context_path", "docker_image_digest", "docker_image", "instance_name", "extra_envs",
+	"failure_reason", "failure_detail", "output_put_signed_url", "custom_token_put_signed_url", "manifest_put_signed_url",
+	"admitted_at", "launched_at", "started_at", "finished_at",
+}
+
+// UpdateJob writes j only if its status is still expectedStatus, so a stale copy of the job
+// never overwrites a concurrent transition. A status change is recorded in job_events atomically.
+func UpdateJob(j *Job, expectedStatus int) error {
+	return DB.Transaction(func(tx *gorm.DB) error {
+		// the columns are selected so that zero values, such as JobStatus_Created or a cleared failure, are written too
+		result := tx.Model(j).Where("job_status = ?", expectedStatus).Select(jobUpdateColumns).Updates(
+			Job{
+				JobStatus:               j.JobStatus,
+				BuildContextPath:        j.BuildContextPath,
+				DockerImageDigest:       j.DockerImageDigest,
+				DockerImage:             j.DockerImage,
+				InstanceName:            j.InstanceName,
+				ExtraEnvs:               j.ExtraEnvs,
+				FailureReason:           j.FailureReason,
+				FailureDetail:           j.FailureDetail,
+				OutputPutSignedUrl:      j.OutputPutSignedUrl,
+				CustomTokenPutSignedUrl: j.CustomTokenPutSignedUrl,
+				ManifestPutSignedUrl:    j.ManifestPutSignedUrl,
+				AdmittedAt:              j.AdmittedAt,
+				LaunchedAt:              j.LaunchedAt,
+				StartedAt:               j.StartedAt,
+				FinishedAt:              j.FinishedAt,
+			})
+		if result.Error != nil {
+			return errors.Wrapf(result.Error, "failed to update job %s", j.UUID)
+		}
+		// updated_at always changes, so no affected row means the status did not match
+		if result.RowsAffected == 0 {
+			return errors.Wrapf(ErrJobStatusConflict, "job %s is no longer in status %d", j.UUID, expectedStatus)
+		}
+		if j.JobStatus == expectedStatus {
+			return nil
+		}
+		return createJobEvent(tx, &JobEvent{
+			JobUUID:   j.UUID,
+			OldStatus: expectedStatus,
+			NewStatus: j.JobStatus,
+			Reason:    j.StatusReason,
 		})
+	})
+}
+
+// UpdateJobRetry writes the retry state of j if its status is still expectedStatus.
+func UpdateJobRetry(j *Job, expectedStatus int) error {
+	result := DB.Model(j).Where("job_status = ?", expectedStatus).Updates(map[string]interface{}{
+		"retry_count":           j.RetryCount,
+		"permanent_error_count": j.PermanentErrorCount,
+		"next_attempt_at":       j.NextAttemptAt,
+	})
 	if result.Error != nil {
-		return errors.Wrap(result.Error, "failed to update job %v")
+		return errors.Wrapf(result.Error, "failed to update retry state of job %s", j.UUID)
+	}
+	if result.RowsAffected == 0 {
+		return errors.Wrapf(ErrJobStatusConflict, "job %s is no longer in status %d", j.UUID, expectedStatus)
 	}
 	return nil
 }
@@ -99,6 +194,14 @@ func DeleteJob(creator string, uuid string) {
 	DB.Model(Job{}).Where("creator = ? AND uuid = ?", creator, uuid).Delete(&Job{})
 }
 
+// RequestJobCancellation flags the job to be cancelled by the reconciler.
+func RequestJobCancellation(j *Job) error {
+	if err := DB.Model(j).Update("cancel_requested", true).Error; err != nil {
+		return errors.Wrap(err, "failed to request job cancellation")
+	}
+	return nil
+}
+
 func QueryJobByUUIDAndCreator(creator string, uuid string) (*Job, error) {
 	db := DB.Model(Job{})
 	var res Job
@@ -108,17 +211,49 @@ func QueryJobByUUIDAndCreator(creator string, uuid string) (*Job, error) {
 	return &res, nil
 }
 
-func GetInProgressJobs(creator string) ([]*Job, error) {
+func QueryJobByUUID(uuid string) (*Job, error) {
+	var res Job
+	if err := DB.Model(Job{}).Where("uuid = ?", uuid).First(&res).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to query job ")
+	}
+	return &res, nil
+}
+
+// QueryJobsByUUIDs returns the jobs with the given UUIDs that were not deleted.
+func QueryJobsByUUIDs(uuids []string) ([]*Job, error) {
 	var res []*Job
-	if err := DB.Model(Job{}).Where("creator = ? AND job_status in (0, 1, 3, 4)", creator).Find(&res).Error; err != nil {
-		return nil, errors.Wrap(err, "failed to find finished job status")
+	if err := DB.Model(Job{}).Where("uuid IN ?", uuids).Find(&res).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to query jobs ")
 	}
 	return res, nil
 }
 
+// QueryJobsOnVMBetween returns the jobs of creator, or of all creators if empty, whose VM ran between start and end.
+func QueryJobsOnVMBetween(creator string, start, end time.Time) ([]*Job, error) {
+	db := DB.Model(Job{}).Where("started_at < ? AND (finished_at IS NULL OR finished_at > ?)", end, start)
+	if creator != "" {
+		db = db.Where("creator = ?", creator)
+	}
+	var res []*Job
+	if err := db.Order("id").Find(&res).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to query jobs ")
+	}
+	return res, nil
+}
+
+// CountQueuedJobs returns the number of jobs of creator waiting for capacity.
+func CountQueuedJobs(creator string) (int64, error) {
+	var count int64
+	if err := DB.Model(Job{}).Where("creator = ? AND job_status = 11", creator).Count(&count).Error; err != nil {
+		return 0, errors.Wrap(err, "failed to count queued jobs")
+	}
+	return count, nil
+}
+
+// GetAllInProgressJobs returns the jobs that are queued or not finished, in submission order.
 func GetAllInProgressJobs() ([]*Job, error) {
 	var res []*Job
-	if err := DB.Model(Job{}).Where("job_status in (0, 1, 3, 4)").Find(&res).Error; err != nil {
+	if err := DB.Model(Job{}).Where("job_status in (0, 1, 3, 4, 11)").Order("id").Find(&res).Error; err != nil {
 		return nil, errors.Wrap(err, "failed to find finished job status")
 	}
 	return res, nil
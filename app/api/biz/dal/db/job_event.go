@@ -0,0 +1,58 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// JobEvent records a status transition of a job.
+type JobEvent struct {
+	ID        uint64    `gorm:"primarykey" json:"id"`
+	JobUUID   string    `gorm:"index;size:64" json:"job_uuid"`
+	OldStatus int       `gorm:"old_status" json:"old_status"`
+	NewStatus int       `gorm:"new_status" json:"new_status"`
+	Reason    string    `gorm:"type:text" json:"reason"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (JobEvent) TableName() string {
+	return "job_events"
+}
+
+func CreateJobEvent(event *JobEvent) error {
+	return createJobEvent(DB, event)
+}
+
+func createJobEvent(tx *gorm.DB, event *JobEvent) error {
+	if event.CreatedAt.IsZero() {
+		event.CreatedAt = time.Now()
+	}
+	if err := tx.Create(event).Error; err != nil {
+		return errors.Wrap(err, "failed to insert event into job_events table")
+	}
+	return nil
+}
+
+func QueryJobEventsByUUID(uuid string) ([]*JobEvent, error) {
+	var res []*JobEvent
+	if err := DB.Model(JobEvent{}).Where("job_uuid = ?", uuid).Order("id ASC").Find(&res).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to query job events")
+	}
+	return res, nil
+}
@@ -0,0 +1,211 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/common/test/assert"
+	"github.com/pkg/errors"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// fakeJobsDriver is a database/sql driver holding a single row of the jobs table. It understands the statements
+// gorm generates for UpdateJob and QueryJobByUUID, and records the job events inserted.
+type fakeJobsDriver struct {
+	mu     sync.Mutex
+	row    map[string]driver.Value
+	events [][]driver.Value
+}
+
+var (
+	setColumnRe = regexp.MustCompile("`(\\w+)`=\\?")
+	placeholder = regexp.MustCompile(`\?`)
+)
+
+func (d *fakeJobsDriver) Open(string) (driver.Conn, error) {
+	return &fakeConn{d: d}, nil
+}
+
+func (d *fakeJobsDriver) exec(query string, args []driver.Value) (driver.Result, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	switch {
+	case strings.HasPrefix(query, "UPDATE `jobs` SET "):
+		set, where, _ := strings.Cut(strings.TrimPrefix(query, "UPDATE `jobs` SET "), " WHERE ")
+		columns := setColumnRe.FindAllStringSubmatch(set, -1)
+		if i := strings.Index(where, "job_status = ?"); i >= 0 {
+			expected := args[len(columns)+len(placeholder.FindAllString(where[:i], -1))]
+			if fmt.Sprint(expected) != fmt.Sprint(d.row["job_status"]) {
+				return driver.RowsAffected(0), nil
+			}
+		}
+		for i, c := range columns {
+			d.row[c[1]] = args[i]
+		}
+		return driver.RowsAffected(1), nil
+	case strings.HasPrefix(query, "INSERT INTO `job_events`"):
+		d.events = append(d.events, args)
+		return fakeResult(len(d.events)), nil
+	}
+	return nil, errors.Errorf("unexpected statement %q", query)
+}
+
+func (d *fakeJobsDriver) query(query string) (driver.Rows, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if !strings.HasPrefix(query, "SELECT * FROM `jobs`") {
+		return nil, errors.Errorf("unexpected query %q", query)
+	}
+	rows := &fakeRows{}
+	for c, v := range d.row {
+		rows.columns = append(rows.columns, c)
+		rows.values = append(rows.values, v)
+	}
+	return rows, nil
+}
+
+type fakeConn struct{ d *fakeJobsDriver }
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeStmt{d: c.d, query: query}, nil
+}
+func (c *fakeConn) Close() error              { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error) { return c, nil }
+func (c *fakeConn) Commit() error             { return nil }
+func (c *fakeConn) Rollback() error           { return nil }
+
+type fakeStmt struct {
+	d     *fakeJobsDriver
+	query string
+}
+
+func (s *fakeStmt) Close() error                                    { return nil }
+func (s *fakeStmt) NumInput() int                                   { return -1 }
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) { return s.d.exec(s.query, args) }
+func (s *fakeStmt) Query([]driver.Value) (driver.Rows, error)       { return s.d.query(s.query) }
+
+type fakeResult int64
+
+func (r fakeResult) LastInsertId() (int64, error) { return int64(r), nil }
+func (r fakeResult) RowsAffected() (int64, error) { return 1, nil }
+
+type fakeRows struct {
+	columns []string
+	values  []driver.Value
+	done    bool
+}
+
+func (r *fakeRows) Columns() []string { return r.columns }
+func (r *fakeRows) Close() error      { return nil }
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+	r.done = true
+	copy(dest, r.values)
+	return nil
+}
+
+// useFakeJobsDB points DB at a fake database holding job j, and returns the fake.
+func useFakeJobsDB(t *testing.T, j *Job) *fakeJobsDriver {
+	d := &fakeJobsDriver{row: map[string]driver.Value{
+		"id":                    int64(j.ID),
+		"uuid":                  j.UUID,
+		"job_status":            int64(j.JobStatus),
+		"build_context_path":    j.BuildContextPath,
+		"docker_image":          j.DockerImage,
+		"docker_image_digest":   j.DockerImageDigest,
+		"instance_name":         j.InstanceName,
+		"failure_reason":        j.FailureReason,
+		"failure_detail":        j.FailureDetail,
+		"created_at":            j.CreatedAt,
+		"updated_at":            j.UpdatedAt,
+		"deleted_at":            nil,
+		"started_at":            nil,
+		"finished_at":           nil,
+		"extra_envs":            nil,
+		"cancel_requested":      false,
+		"timeout_seconds":       j.TimeoutSeconds,
+		"permanent_error_count": int64(0),
+	}}
+	sqlDB := sql.OpenDB(fakeConnector{d})
+	t.Cleanup(func() { sqlDB.Close() })
+	old := DB
+	var err error
+	DB, err = gorm.Open(mysql.New(mysql.Config{Conn: sqlDB, SkipInitializeWithVersion: true}), &gorm.Config{
+		SkipDefaultTransaction: true,
+		Logger:                 logger.Discard,
+	})
+	assert.Nil(t, err)
+	t.Cleanup(func() { DB = old })
+	return d
+}
+
+type fakeConnector struct{ d *fakeJobsDriver }
+
+func (c fakeConnector) Connect(context.Context) (driver.Conn, error) { return c.d.Open("") }
+func (c fakeConnector) Driver() driver.Driver                        { return c.d }
+
+func TestUpdateJobWritesZeroValues(t *testing.T) {
+	created := time.Now().Add(-time.Hour)
+	d := useFakeJobsDB(t, &Job{
+		Model:         gorm.Model{ID: 1, CreatedAt: created, UpdatedAt: created},
+		UUID:          "job1",
+		JobStatus:     8,
+		InstanceName:  "vm-job1",
+		FailureReason: "quota exceeded",
+		FailureDetail: "too many VMs",
+	})
+
+	j, err := QueryJobByUUID("job1")
+	assert.Nil(t, err)
+	j.JobStatus = 3
+	j.InstanceName = ""
+	j.FailureReason = ""
+	j.FailureDetail = ""
+	assert.Nil(t, UpdateJob(j, 8))
+
+	j, err = QueryJobByUUID("job1")
+	assert.Nil(t, err)
+	assert.DeepEqual(t, 3, j.JobStatus)
+	assert.DeepEqual(t, "", j.InstanceName)
+	assert.DeepEqual(t, "", j.FailureReason)
+	assert.DeepEqual(t, "", j.FailureDetail)
+	assert.True(t, j.UpdatedAt.After(created))
+	assert.DeepEqual(t, 1, len(d.events))
+
+	// a stale copy of the job is not written
+	j.JobStatus = 4
+	assert.True(t, errors.Is(UpdateJob(j, 8), ErrJobStatusConflict))
+	j, err = QueryJobByUUID("job1")
+	assert.Nil(t, err)
+	assert.DeepEqual(t, 3, j.JobStatus)
+}
+
+func TestUpdateJobAdmitsQueuedJob(t *testing.T) {
+	d := useFakeJobsDB(t, &Job{Model: gorm.Model{ID: 1}, UUID: "job1", JobStatus: 11})
+
+	j, err := QueryJobByUUID("job1")
+	assert.Nil(t, err)
+	j.JobStatus = 0
+	j.StatusReason = "job is admitted within the quotas"
+	assert.Nil(t, UpdateJob(j, 11))
+
+	j, err = QueryJobByUUID("job1")
+	assert.Nil(t, err)
+	assert.DeepEqual(t, 0, j.JobStatus)
+	assert.DeepEqual(t, 1, len(d.events))
+
+	// the job is admitted only once
+	assert.True(t, errors.Is(UpdateJob(j, 11), ErrJobStatusConflict))
+}
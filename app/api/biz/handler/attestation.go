@@ -0,0 +1,56 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/cloudwego/hertz/pkg/common/utils"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"github.com/manatee-project/manatee/app/api/biz/pkg/attestation"
+	"github.com/manatee-project/manatee/app/api/biz/pkg/config"
+)
+
+// MockAttestationJWKS serves the public key mock TEEs sign their attestation tokens with,
+// so that verifiers can check the tokens of deployments without Confidential Space.
+func MockAttestationJWKS(ctx context.Context, c *app.RequestContext) {
+	cfg := config.Get().Attestation
+	keyFile := cfg.MockKeyFile
+	if !cfg.MockEnabled || keyFile == "" {
+		c.JSON(consts.StatusNotFound, utils.H{
+			"message": "mock attestation is not enabled",
+		})
+		return
+	}
+	key, err := attestation.LoadMockKey(keyFile)
+	if err != nil {
+		hlog.Errorf("[Attestation Handler]failed to load mock key: %+v", err)
+		c.JSON(consts.StatusInternalServerError, utils.H{
+			"message": "failed to load mock attestation key",
+		})
+		return
+	}
+	data, err := attestation.MockKeySet(key)
+	if err != nil {
+		hlog.Errorf("[Attestation Handler]failed to marshal mock key set: %+v", err)
+		c.JSON(consts.StatusInternalServerError, utils.H{
+			"message": "failed to marshal mock attestation key",
+		})
+		return
+	}
+	c.Data(consts.StatusOK, "application/json", data)
+}
@@ -16,6 +16,7 @@
 package job
 
 import (
+	"bytes"
 	"context"
 	"mime/multipart"
 
@@ -24,17 +25,24 @@ import (
 	"github.com/cloudwego/hertz/pkg/protocol/consts"
 
 	"github.com/manatee-project/manatee/app/api/biz/model/job"
+	"github.com/manatee-project/manatee/app/api/biz/mw"
+	"github.com/manatee-project/manatee/app/api/biz/pkg/config"
 	"github.com/manatee-project/manatee/app/api/biz/pkg/errno"
 	"github.com/manatee-project/manatee/app/api/biz/pkg/utils"
 	"github.com/manatee-project/manatee/app/api/biz/service"
 )
 
 type FileParas struct {
-	FileHeader      *multipart.FileHeader `form:"file"`
-	Creator         string                `form:"creator"`
-	Envs            []*job.Env            `form:"envs"`
-	JupyterFileName string                `form:"filename"`
-	AccessToken     string                `header:"Authorization,required"`
+	FileHeader               *multipart.FileHeader `form:"file"`
+	Creator                  string                `form:"creator"`
+	Envs                     []*job.Env            `form:"envs"`
+	JupyterFileName          string                `form:"filename"`
+	Timeout                  int64                 `form:"timeout"`
+	MachineType              string                `form:"machine_type"`
+	DiskSizeGB               int64                 `form:"disk_size_gb"`
+	ConfidentialInstanceType string                `form:"confidential_instance_type"`
+	Priority                 int64                 `form:"priority"`
+	AccessToken              string                `header:"Authorization,required"`
 }
 
 // CreateJob .
@@ -49,10 +57,24 @@ func SubmitJob(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
+	creator, err := mw.AuthorizeCreator(c, formReq.Creator)
+	if err != nil {
+		hlog.Errorf("[Job Handler]failed to authorize creator: %+v", err)
+		utils.ReturnsJSONErrorWithStatus(c, consts.StatusForbidden, err)
+		return
+	}
+
 	req.JupyterFileName = formReq.JupyterFileName
 	req.AccessToken = formReq.AccessToken
-	req.Creator = formReq.Creator
+	req.Creator = creator
 	req.Envs = formReq.Envs
+	req.Timeout = formReq.Timeout
+	req.Priority = formReq.Priority
+	req.Resources = &job.ResourceProfile{
+		MachineType:              formReq.MachineType,
+		DiskSizeGb:               formReq.DiskSizeGB,
+		ConfidentialInstanceType: formReq.ConfidentialInstanceType,
+	}
 	file, err := formReq.FileHeader.Open()
 	if err != nil {
 		hlog.Errorf("[Job Handler]failed to open file %+v", err)
@@ -61,7 +83,11 @@ func SubmitJob(ctx context.Context, c *app.RequestContext) {
 	}
 	defer file.Close()
 
-	UUID, err := service.NewJobService(ctx).SubmitJob(&req, file)
+	var groups []string
+	if identity, ok := mw.GetIdentity(c); ok {
+		groups = identity.Groups
+	}
+	UUID, err := service.NewJobService(ctx).SubmitJob(&req, groups, file)
 	if err != nil {
 		hlog.Errorf("[Job Handler]failed to submit file %+v", err)
 		utils.ReturnsJSONError(c, err)
@@ -85,6 +111,11 @@ func QueryJob(ctx context.Context, c *app.RequestContext) {
 		utils.ReturnsJSONError(c, err)
 		return
 	}
+	if req.Creator, err = mw.AuthorizeCreator(c, req.Creator); err != nil {
+		hlog.Errorf("[Job Handler]failed to authorize creator: %+v", err)
+		utils.ReturnsJSONErrorWithStatus(c, consts.StatusForbidden, err)
+		return
+	}
 	jobs, total, err := service.NewJobService(ctx).QueryUsersJobs(&req)
 	if err != nil {
 		hlog.Errorf("[Job Handler]failed to query user jobs %+v", err)
@@ -99,6 +130,91 @@ func QueryJob(ctx context.Context, c *app.RequestContext) {
 	})
 }
 
+// QueryJobDetail .
+// @router /v1/job/:uuid [GET]
+func QueryJobDetail(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req job.QueryJobDetailRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		hlog.Errorf("[Job Handler]failed to parse parameters: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	if req.Creator, err = mw.AuthorizeCreator(c, req.Creator); err != nil {
+		hlog.Errorf("[Job Handler]failed to authorize creator: %+v", err)
+		utils.ReturnsJSONErrorWithStatus(c, consts.StatusForbidden, err)
+		return
+	}
+	detail, err := service.NewJobService(ctx).QueryJobDetail(&req)
+	if err != nil {
+		hlog.Errorf("[Job Handler]failed to query job detail: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	c.JSON(consts.StatusOK, job.QueryJobDetailResponse{
+		Code: errno.SuccessCode,
+		Msg:  errno.SuccessMsg,
+		Job:  detail,
+	})
+}
+
+// QueryJobEvents .
+// @router /v1/job/:uuid/events [GET]
+func QueryJobEvents(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req job.QueryJobEventsRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		hlog.Errorf("[Job Handler]failed to parse parameters: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	if req.Creator, err = mw.AuthorizeCreator(c, req.Creator); err != nil {
+		hlog.Errorf("[Job Handler]failed to authorize creator: %+v", err)
+		utils.ReturnsJSONErrorWithStatus(c, consts.StatusForbidden, err)
+		return
+	}
+	events, err := service.NewJobService(ctx).QueryJobEvents(&req)
+	if err != nil {
+		hlog.Errorf("[Job Handler]failed to query job events: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	c.JSON(consts.StatusOK, job.QueryJobEventsResponse{
+		Code:   errno.SuccessCode,
+		Msg:    errno.SuccessMsg,
+		Events: events,
+	})
+}
+
+// QueryJobBuildLogs .
+// @router /v1/job/:uuid/build-logs [GET]
+func QueryJobBuildLogs(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req job.QueryJobBuildLogsRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		hlog.Errorf("[Job Handler]failed to parse parameters: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	if req.Creator, err = mw.AuthorizeCreator(c, req.Creator); err != nil {
+		hlog.Errorf("[Job Handler]failed to authorize creator: %+v", err)
+		utils.ReturnsJSONErrorWithStatus(c, consts.StatusForbidden, err)
+		return
+	}
+	logs, err := service.NewJobService(ctx).GetJobBuildLogs(&req)
+	if err != nil {
+		hlog.Errorf("[Job Handler]failed to get job build logs: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	// the body is sent with chunked encoding and the stream is closed once it is written
+	c.SetContentType("text/plain; charset=utf-8")
+	c.SetBodyStream(logs, -1)
+}
+
 // DeleteJob .
 // @router /v1/job/delete/ [POST]
 func DeleteJob(ctx context.Context, c *app.RequestContext) {
@@ -110,6 +226,11 @@ func DeleteJob(ctx context.Context, c *app.RequestContext) {
 		utils.ReturnsJSONError(c, err)
 		return
 	}
+	if req.Creator, err = mw.AuthorizeCreator(c, req.Creator); err != nil {
+		hlog.Errorf("[Job Handler]failed to authorize creator: %+v", err)
+		utils.ReturnsJSONErrorWithStatus(c, consts.StatusForbidden, err)
+		return
+	}
 	service.NewJobService(ctx).DeleteJob(&req)
 	c.JSON(consts.StatusOK, job.DeleteJobResponse{
 		Code: errno.SuccessCode,
@@ -117,6 +238,34 @@ func DeleteJob(ctx context.Context, c *app.RequestContext) {
 	})
 }
 
+// CancelJob .
+// @router /v1/job/cancel/ [POST]
+func CancelJob(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req job.CancelJobRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		hlog.Errorf("[Job Handler]failed to parse parameters: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	if req.Creator, err = mw.AuthorizeCreator(c, req.Creator); err != nil {
+		hlog.Errorf("[Job Handler]failed to authorize creator: %+v", err)
+		utils.ReturnsJSONErrorWithStatus(c, consts.StatusForbidden, err)
+		return
+	}
+	err = service.NewJobService(ctx).CancelJob(&req)
+	if err != nil {
+		hlog.Errorf("[Job Handler]failed to cancel job: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	c.JSON(consts.StatusOK, job.CancelJobResponse{
+		Code: errno.SuccessCode,
+		Msg:  errno.SuccessMsg,
+	})
+}
+
 // DownloadJobOutput .
 // @router /v1/job/file/download/ [POST]
 func DownloadJobOutput(ctx context.Context, c *app.RequestContext) {
@@ -128,6 +277,11 @@ func DownloadJobOutput(ctx context.Context, c *app.RequestContext) {
 		utils.ReturnsJSONError(c, err)
 		return
 	}
+	if req.Creator, err = mw.AuthorizeCreator(c, req.Creator); err != nil {
+		hlog.Errorf("[Job Handler]failed to authorize creator: %+v", err)
+		utils.ReturnsJSONErrorWithStatus(c, consts.StatusForbidden, err)
+		return
+	}
 	signedUrl, filename, err := service.NewJobService(ctx).DownloadJobOutput(&req)
 	if err != nil {
 		hlog.Errorf("[Job Handler]failed to download job output: %+v", err)
@@ -154,15 +308,93 @@ func QueryJobAttestationReport(ctx context.Context, c *app.RequestContext) {
 		utils.ReturnsJSONError(c, err)
 		return
 	}
-	signedUrl, err := service.NewJobService(ctx).GetJobAttestationReport(&req)
+	if req.Creator, err = mw.AuthorizeCreator(c, req.Creator); err != nil {
+		hlog.Errorf("[Job Handler]failed to authorize creator: %+v", err)
+		utils.ReturnsJSONErrorWithStatus(c, consts.StatusForbidden, err)
+		return
+	}
+	resp, err := service.NewJobService(ctx).GetJobAttestationReport(&req)
 	if err != nil {
 		hlog.Errorf("[Job Handler]failed to query job attestation report: %+v", err)
 		utils.ReturnsJSONError(c, err)
 		return
 	}
-	c.JSON(consts.StatusOK, job.QueryJobAttestationResponse{
-		Code:      errno.SuccessCode,
-		Msg:       errno.SuccessMsg,
-		SignedURL: signedUrl,
+	resp.Code = errno.SuccessCode
+	resp.Msg = errno.SuccessMsg
+	c.JSON(consts.StatusOK, resp)
+}
+
+// QueryUsage .
+// @router /v1/usage [GET]
+func QueryUsage(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req job.QueryUsageRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		hlog.Errorf("[Job Handler]failed to parse parameters: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	billing := config.Get().Billing
+	// billing viewers can query the usage of any creator, and of all creators with an empty creator
+	if identity, ok := mw.GetIdentity(c); !ok || !identity.InAnyGroup(billing.ViewerGroups) {
+		if req.Creator, err = mw.AuthorizeCreator(c, req.Creator); err != nil {
+			hlog.Errorf("[Job Handler]failed to authorize creator: %+v", err)
+			utils.ReturnsJSONErrorWithStatus(c, consts.StatusForbidden, err)
+			return
+		}
+	}
+	usage, err := service.NewUsageService(ctx).QueryUsage(&req)
+	if err != nil {
+		hlog.Errorf("[Job Handler]failed to query usage %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	if req.Format == "csv" {
+		var buf bytes.Buffer
+		if err := service.WriteUsageCSV(&buf, usage, billing.Currency); err != nil {
+			hlog.Errorf("[Job Handler]failed to write usage %+v", err)
+			utils.ReturnsJSONError(c, err)
+			return
+		}
+		c.Header("Content-Disposition", `attachment; filename="usage.csv"`)
+		c.Data(consts.StatusOK, "text/csv; charset=utf-8", buf.Bytes())
+		return
+	}
+	c.JSON(consts.StatusOK, job.QueryUsageResponse{
+		Code:     errno.SuccessCode,
+		Msg:      errno.SuccessMsg,
+		Usage:    usage,
+		Currency: billing.Currency,
+	})
+}
+
+// VerifyJobAttestation .
+// @router /v1/job/:uuid/attestation/verify [GET]
+func VerifyJobAttestation(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req job.VerifyJobAttestationRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		hlog.Errorf("[Job Handler]failed to parse parameters: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	if req.Creator, err = mw.AuthorizeCreator(c, req.Creator); err != nil {
+		hlog.Errorf("[Job Handler]failed to authorize creator: %+v", err)
+		utils.ReturnsJSONErrorWithStatus(c, consts.StatusForbidden, err)
+		return
+	}
+	verified, checks, err := service.NewJobService(ctx).VerifyJobAttestation(&req)
+	if err != nil {
+		hlog.Errorf("[Job Handler]failed to verify job attestation: %+v", err)
+		utils.ReturnsJSONError(c, err)
+		return
+	}
+	c.JSON(consts.StatusOK, job.VerifyJobAttestationResponse{
+		Code:     errno.SuccessCode,
+		Msg:      errno.SuccessMsg,
+		Verified: verified,
+		Checks:   checks,
 	})
 }
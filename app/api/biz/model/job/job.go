@@ -23,6 +23,8 @@ const (
 	JobStatus_VMFailed            JobStatus = 7
 	JobStatus_VMOther             JobStatus = 8
 	JobStatus_VMLaunchFailed      JobStatus = 9
+	JobStatus_Timeout             JobStatus = 10
+	JobStatus_Queued              JobStatus = 11
 )
 
 func (p JobStatus) String() string {
@@ -47,6 +49,10 @@ func (p JobStatus) String() string {
 		return "VMOther"
 	case JobStatus_VMLaunchFailed:
 		return "VMLaunchFailed"
+	case JobStatus_Timeout:
+		return "Timeout"
+	case JobStatus_Queued:
+		return "Queued"
 	}
 	return "<UNSET>"
 }
@@ -73,6 +79,10 @@ func JobStatusFromString(s string) (JobStatus, error) {
 		return JobStatus_VMOther, nil
 	case "VMLaunchFailed":
 		return JobStatus_VMLaunchFailed, nil
+	case "Timeout":
+		return JobStatus_Timeout, nil
+	case "Queued":
+		return JobStatus_Queued, nil
 	}
 	return JobStatus(0), fmt.Errorf("not a valid JobStatus string")
 }
@@ -100,6 +110,7 @@ type Job struct {
 	JupyterFileName string    `thrift:"jupyter_file_name,5" form:"jupyter_file_name" json:"jupyter_file_name" query:"jupyter_file_name"`
 	CreatedAt       string    `thrift:"created_at,6" form:"created_at" json:"created_at" query:"created_at"`
 	UpdatedAt       string    `thrift:"updated_at,7" form:"updated_at" json:"updated_at" query:"updated_at"`
+	FailureReason   string    `thrift:"failure_reason,8" form:"failure_reason" json:"failure_reason" query:"failure_reason"`
 }
 
 func NewJob() *Job {
@@ -137,6 +148,10 @@ func (p *Job) GetUpdatedAt() (v string) {
 	return p.UpdatedAt
 }
 
+func (p *Job) GetFailureReason() (v string) {
+	return p.FailureReason
+}
+
 var fieldIDToName_Job = map[int16]string{
 	1: "id",
 	2: "uuid",
@@ -145,6 +160,7 @@ var fieldIDToName_Job = map[int16]string{
 	5: "jupyter_file_name",
 	6: "created_at",
 	7: "updated_at",
+	8: "failure_reason",
 }
 
 func (p *Job) Read(iprot thrift.TProtocol) (err error) {
@@ -222,6 +238,14 @@ func (p *Job) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 8:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -328,6 +352,17 @@ func (p *Job) ReadField7(iprot thrift.TProtocol) error {
 	p.UpdatedAt = _field
 	return nil
 }
+func (p *Job) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.FailureReason = _field
+	return nil
+}
 
 func (p *Job) Write(oprot thrift.TProtocol) (err error) {
 
@@ -364,6 +399,10 @@ func (p *Job) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 7
 			goto WriteFieldError
 		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -501,6 +540,23 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
 }
 
+func (p *Job) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("failure_reason", thrift.STRING, 8); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.FailureReason); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
 func (p *Job) String() string {
 	if p == nil {
 		return "<nil>"
@@ -696,48 +752,41 @@ func (p *Env) String() string {
 
 }
 
-type SubmitJobRequest struct {
-	JupyterFileName string `thrift:"jupyter_file_name,1" form:"filename" json:"filename" vd:"len($) > 0 && len($) < 128 && regexp('^.*\\.ipynb$') && !regexp('.*\\.\\..*')"`
-	Creator         string `thrift:"creator,2" form:"creator" json:"creator" vd:"len($) > 0 && len($) < 32 && !regexp('.*\\.\\..*')"`
-	Envs            []*Env `thrift:"envs,3" form:"envs" json:"envs"`
-	AccessToken     string `thrift:"access_token,255,required" header:"Authorization,required" json:"access_token,required"`
-}
-
-func NewSubmitJobRequest() *SubmitJobRequest {
-	return &SubmitJobRequest{}
+type ResourceProfile struct {
+	MachineType              string `thrift:"machine_type,1" form:"machine_type" json:"machine_type" query:"machine_type"`
+	DiskSizeGb               int64  `thrift:"disk_size_gb,2" form:"disk_size_gb" json:"disk_size_gb" query:"disk_size_gb"`
+	ConfidentialInstanceType string `thrift:"confidential_instance_type,3" form:"confidential_instance_type" json:"confidential_instance_type" query:"confidential_instance_type"`
 }
 
-func (p *SubmitJobRequest) InitDefault() {
+func NewResourceProfile() *ResourceProfile {
+	return &ResourceProfile{}
 }
 
-func (p *SubmitJobRequest) GetJupyterFileName() (v string) {
-	return p.JupyterFileName
+func (p *ResourceProfile) InitDefault() {
 }
 
-func (p *SubmitJobRequest) GetCreator() (v string) {
-	return p.Creator
+func (p *ResourceProfile) GetMachineType() (v string) {
+	return p.MachineType
 }
 
-func (p *SubmitJobRequest) GetEnvs() (v []*Env) {
-	return p.Envs
+func (p *ResourceProfile) GetDiskSizeGb() (v int64) {
+	return p.DiskSizeGb
 }
 
-func (p *SubmitJobRequest) GetAccessToken() (v string) {
-	return p.AccessToken
+func (p *ResourceProfile) GetConfidentialInstanceType() (v string) {
+	return p.ConfidentialInstanceType
 }
 
-var fieldIDToName_SubmitJobRequest = map[int16]string{
-	1:   "jupyter_file_name",
-	2:   "creator",
-	3:   "envs",
-	255: "access_token",
+var fieldIDToName_ResourceProfile = map[int16]string{
+	1: "machine_type",
+	2: "disk_size_gb",
+	3: "confidential_instance_type",
 }
 
-func (p *SubmitJobRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *ResourceProfile) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetAccessToken bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -762,7 +811,7 @@ func (p *SubmitJobRequest) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -770,19 +819,10 @@ func (p *SubmitJobRequest) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 255:
 			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField255(iprot); err != nil {
+				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetAccessToken = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -799,17 +839,13 @@ func (p *SubmitJobRequest) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetAccessToken {
-		fieldId = 255
-		goto RequiredFieldNotSetError
-	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SubmitJobRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ResourceProfile[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -817,11 +853,9 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_SubmitJobRequest[fieldId]))
 }
 
-func (p *SubmitJobRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *ResourceProfile) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -829,44 +863,21 @@ func (p *SubmitJobRequest) ReadField1(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.JupyterFileName = _field
+	p.MachineType = _field
 	return nil
 }
-func (p *SubmitJobRequest) ReadField2(iprot thrift.TProtocol) error {
+func (p *ResourceProfile) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.Creator = _field
-	return nil
-}
-func (p *SubmitJobRequest) ReadField3(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]*Env, 0, size)
-	values := make([]Env, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
-
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return err
-	}
-	p.Envs = _field
+	p.DiskSizeGb = _field
 	return nil
 }
-func (p *SubmitJobRequest) ReadField255(iprot thrift.TProtocol) error {
+func (p *ResourceProfile) ReadField3(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -874,14 +885,14 @@ func (p *SubmitJobRequest) ReadField255(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.AccessToken = _field
+	p.ConfidentialInstanceType = _field
 	return nil
 }
 
-func (p *SubmitJobRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *ResourceProfile) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SubmitJobRequest"); err != nil {
+	if err = oprot.WriteStructBegin("ResourceProfile"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -897,10 +908,6 @@ func (p *SubmitJobRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
-		if err = p.writeField255(oprot); err != nil {
-			fieldId = 255
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -919,11 +926,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *SubmitJobRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("jupyter_file_name", thrift.STRING, 1); err != nil {
+func (p *ResourceProfile) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("machine_type", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.JupyterFileName); err != nil {
+	if err := oprot.WriteString(p.MachineType); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -936,11 +943,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *SubmitJobRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("creator", thrift.STRING, 2); err != nil {
+func (p *ResourceProfile) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("disk_size_gb", thrift.I64, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Creator); err != nil {
+	if err := oprot.WriteI64(p.DiskSizeGb); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -953,19 +960,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *SubmitJobRequest) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("envs", thrift.LIST, 3); err != nil {
+func (p *ResourceProfile) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("confidential_instance_type", thrift.STRING, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Envs)); err != nil {
-		return err
-	}
-	for _, v := range p.Envs {
-		if err := v.Write(oprot); err != nil {
-			return err
-		}
-	}
-	if err := oprot.WriteListEnd(); err != nil {
+	if err := oprot.WriteString(p.ConfidentialInstanceType); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -978,63 +977,151 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *SubmitJobRequest) writeField255(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("access_token", thrift.STRING, 255); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.AccessToken); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
-}
-
-func (p *SubmitJobRequest) String() string {
+func (p *ResourceProfile) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SubmitJobRequest(%+v)", *p)
+	return fmt.Sprintf("ResourceProfile(%+v)", *p)
 
 }
 
-type SubmitJobResponse struct {
-	Code int32  `thrift:"code,1" form:"code" json:"code" query:"code"`
-	Msg  string `thrift:"msg,2" form:"msg" json:"msg" query:"msg"`
-	UUID string `thrift:"uuid,3" form:"uuid" json:"uuid" query:"uuid"`
+type JobDetail struct {
+	ID                   int64            `thrift:"id,1" form:"id" json:"id" query:"id"`
+	UUID                 string           `thrift:"uuid,2" form:"uuid" json:"uuid" query:"uuid"`
+	Creator              string           `thrift:"creator,3" form:"creator" json:"creator" query:"creator"`
+	JobStatus            JobStatus        `thrift:"job_status,4" form:"job_status" json:"job_status" query:"job_status"`
+	JupyterFileName      string           `thrift:"jupyter_file_name,5" form:"jupyter_file_name" json:"jupyter_file_name" query:"jupyter_file_name"`
+	CreatedAt            string           `thrift:"created_at,6" form:"created_at" json:"created_at" query:"created_at"`
+	UpdatedAt            string           `thrift:"updated_at,7" form:"updated_at" json:"updated_at" query:"updated_at"`
+	DockerImage          string           `thrift:"docker_image,8" form:"docker_image" json:"docker_image" query:"docker_image"`
+	DockerImageDigest    string           `thrift:"docker_image_digest,9" form:"docker_image_digest" json:"docker_image_digest" query:"docker_image_digest"`
+	InstanceName         string           `thrift:"instance_name,10" form:"instance_name" json:"instance_name" query:"instance_name"`
+	Dockerfile           string           `thrift:"dockerfile,11" form:"dockerfile" json:"dockerfile" query:"dockerfile"`
+	Envs                 []*Env           `thrift:"envs,12" form:"envs" json:"envs" query:"envs"`
+	FailureReason        string           `thrift:"failure_reason,13" form:"failure_reason" json:"failure_reason" query:"failure_reason"`
+	FailureDetail        string           `thrift:"failure_detail,14" form:"failure_detail" json:"failure_detail" query:"failure_detail"`
+	Timeout              int64            `thrift:"timeout,15" form:"timeout" json:"timeout" query:"timeout"`
+	Resources            *ResourceProfile `thrift:"resources,16" form:"resources" json:"resources" query:"resources"`
+	Priority             int64            `thrift:"priority,17" form:"priority" json:"priority" query:"priority"`
+	AttestationAudience  string           `thrift:"attestation_audience,18" form:"attestation_audience" json:"attestation_audience" query:"attestation_audience"`
+	AttestationTokenType string           `thrift:"attestation_token_type,19" form:"attestation_token_type" json:"attestation_token_type" query:"attestation_token_type"`
 }
 
-func NewSubmitJobResponse() *SubmitJobResponse {
-	return &SubmitJobResponse{}
+func NewJobDetail() *JobDetail {
+	return &JobDetail{}
 }
 
-func (p *SubmitJobResponse) InitDefault() {
+func (p *JobDetail) InitDefault() {
 }
 
-func (p *SubmitJobResponse) GetCode() (v int32) {
-	return p.Code
+func (p *JobDetail) GetID() (v int64) {
+	return p.ID
 }
 
-func (p *SubmitJobResponse) GetMsg() (v string) {
-	return p.Msg
+func (p *JobDetail) GetUUID() (v string) {
+	return p.UUID
 }
 
-func (p *SubmitJobResponse) GetUUID() (v string) {
-	return p.UUID
+func (p *JobDetail) GetCreator() (v string) {
+	return p.Creator
 }
 
-var fieldIDToName_SubmitJobResponse = map[int16]string{
-	1: "code",
-	2: "msg",
-	3: "uuid",
+func (p *JobDetail) GetJobStatus() (v JobStatus) {
+	return p.JobStatus
 }
 
-func (p *SubmitJobResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *JobDetail) GetJupyterFileName() (v string) {
+	return p.JupyterFileName
+}
+
+func (p *JobDetail) GetCreatedAt() (v string) {
+	return p.CreatedAt
+}
+
+func (p *JobDetail) GetUpdatedAt() (v string) {
+	return p.UpdatedAt
+}
+
+func (p *JobDetail) GetDockerImage() (v string) {
+	return p.DockerImage
+}
+
+func (p *JobDetail) GetDockerImageDigest() (v string) {
+	return p.DockerImageDigest
+}
+
+func (p *JobDetail) GetInstanceName() (v string) {
+	return p.InstanceName
+}
+
+func (p *JobDetail) GetDockerfile() (v string) {
+	return p.Dockerfile
+}
+
+func (p *JobDetail) GetEnvs() (v []*Env) {
+	return p.Envs
+}
+
+func (p *JobDetail) GetFailureReason() (v string) {
+	return p.FailureReason
+}
+
+func (p *JobDetail) GetFailureDetail() (v string) {
+	return p.FailureDetail
+}
+
+func (p *JobDetail) GetTimeout() (v int64) {
+	return p.Timeout
+}
+
+var JobDetail_Resources_DEFAULT *ResourceProfile
+
+func (p *JobDetail) GetResources() (v *ResourceProfile) {
+	if !p.IsSetResources() {
+		return JobDetail_Resources_DEFAULT
+	}
+	return p.Resources
+}
+
+func (p *JobDetail) GetPriority() (v int64) {
+	return p.Priority
+}
+
+func (p *JobDetail) GetAttestationAudience() (v string) {
+	return p.AttestationAudience
+}
+
+func (p *JobDetail) GetAttestationTokenType() (v string) {
+	return p.AttestationTokenType
+}
+
+var fieldIDToName_JobDetail = map[int16]string{
+	1:  "id",
+	2:  "uuid",
+	3:  "creator",
+	4:  "job_status",
+	5:  "jupyter_file_name",
+	6:  "created_at",
+	7:  "updated_at",
+	8:  "docker_image",
+	9:  "docker_image_digest",
+	10: "instance_name",
+	11: "dockerfile",
+	12: "envs",
+	13: "failure_reason",
+	14: "failure_detail",
+	15: "timeout",
+	16: "resources",
+	17: "priority",
+	18: "attestation_audience",
+	19: "attestation_token_type",
+}
+
+func (p *JobDetail) IsSetResources() bool {
+	return p.Resources != nil
+}
+
+func (p *JobDetail) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -1054,7 +1141,7 @@ func (p *SubmitJobResponse) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -1077,58 +1164,175 @@ func (p *SubmitJobResponse) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		default:
-			if err = iprot.Skip(fieldTypeId); err != nil {
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		}
-		if err = iprot.ReadFieldEnd(); err != nil {
-			goto ReadFieldEndError
-		}
-	}
-	if err = iprot.ReadStructEnd(); err != nil {
-		goto ReadStructEndError
-	}
-
-	return nil
-ReadStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
-ReadFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SubmitJobResponse[fieldId]), err)
-SkipFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-
-ReadFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
-ReadStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-}
-
-func (p *SubmitJobResponse) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field int32
-	if v, err := iprot.ReadI32(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.Code = _field
-	return nil
-}
-func (p *SubmitJobResponse) ReadField2(iprot thrift.TProtocol) error {
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 11:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 12:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField12(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 13:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField13(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 14:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField14(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 15:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField15(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 16:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField16(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 17:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField17(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 18:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField18(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 19:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField19(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobDetail[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *JobDetail) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.Msg = _field
+	p.ID = _field
 	return nil
 }
-func (p *SubmitJobResponse) ReadField3(iprot thrift.TProtocol) error {
+func (p *JobDetail) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -1139,229 +1343,151 @@ func (p *SubmitJobResponse) ReadField3(iprot thrift.TProtocol) error {
 	p.UUID = _field
 	return nil
 }
+func (p *JobDetail) ReadField3(iprot thrift.TProtocol) error {
 
-func (p *SubmitJobResponse) Write(oprot thrift.TProtocol) (err error) {
-
-	var fieldId int16
-	if err = oprot.WriteStructBegin("SubmitJobResponse"); err != nil {
-		goto WriteStructBeginError
-	}
-	if p != nil {
-		if err = p.writeField1(oprot); err != nil {
-			fieldId = 1
-			goto WriteFieldError
-		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
-	}
-	if err = oprot.WriteFieldStop(); err != nil {
-		goto WriteFieldStopError
-	}
-	if err = oprot.WriteStructEnd(); err != nil {
-		goto WriteStructEndError
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
+	p.Creator = _field
 	return nil
-WriteStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-WriteFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
-WriteFieldStopError:
-	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
-WriteStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
+func (p *JobDetail) ReadField4(iprot thrift.TProtocol) error {
 
-func (p *SubmitJobResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("code", thrift.I32, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI32(p.Code); err != nil {
+	var _field JobStatus
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
+	} else {
+		_field = JobStatus(v)
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
+	p.JobStatus = _field
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
+func (p *JobDetail) ReadField5(iprot thrift.TProtocol) error {
 
-func (p *SubmitJobResponse) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("msg", thrift.STRING, 2); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.Msg); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
+	p.JupyterFileName = _field
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
+func (p *JobDetail) ReadField6(iprot thrift.TProtocol) error {
 
-func (p *SubmitJobResponse) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("uuid", thrift.STRING, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.UUID); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
+	p.CreatedAt = _field
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
+func (p *JobDetail) ReadField7(iprot thrift.TProtocol) error {
 
-func (p *SubmitJobResponse) String() string {
-	if p == nil {
-		return "<nil>"
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
-	return fmt.Sprintf("SubmitJobResponse(%+v)", *p)
-
+	p.UpdatedAt = _field
+	return nil
 }
+func (p *JobDetail) ReadField8(iprot thrift.TProtocol) error {
 
-type QueryJobRequest struct {
-	Page        int64  `thrift:"page,1" form:"page" json:"page" query:"page" vd:"$>0"`
-	PageSize    int64  `thrift:"page_size,2" form:"page_size" json:"page_size" query:"page_size" vd:"$ > 0 || $ <= 100"`
-	Creator     string `thrift:"creator,3" form:"creator" json:"creator" vd:"len($) > 0 && len($) < 32 && !regexp('.*\\.\\..*')"`
-	AccessToken string `thrift:"access_token,255,required" header:"Authorization,required" json:"access_token,required"`
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.DockerImage = _field
+	return nil
 }
+func (p *JobDetail) ReadField9(iprot thrift.TProtocol) error {
 
-func NewQueryJobRequest() *QueryJobRequest {
-	return &QueryJobRequest{}
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.DockerImageDigest = _field
+	return nil
 }
+func (p *JobDetail) ReadField10(iprot thrift.TProtocol) error {
 
-func (p *QueryJobRequest) InitDefault() {
-}
-
-func (p *QueryJobRequest) GetPage() (v int64) {
-	return p.Page
-}
-
-func (p *QueryJobRequest) GetPageSize() (v int64) {
-	return p.PageSize
-}
-
-func (p *QueryJobRequest) GetCreator() (v string) {
-	return p.Creator
-}
-
-func (p *QueryJobRequest) GetAccessToken() (v string) {
-	return p.AccessToken
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.InstanceName = _field
+	return nil
 }
+func (p *JobDetail) ReadField11(iprot thrift.TProtocol) error {
 
-var fieldIDToName_QueryJobRequest = map[int16]string{
-	1:   "page",
-	2:   "page_size",
-	3:   "creator",
-	255: "access_token",
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Dockerfile = _field
+	return nil
 }
-
-func (p *QueryJobRequest) Read(iprot thrift.TProtocol) (err error) {
-
-	var fieldTypeId thrift.TType
-	var fieldId int16
-	var issetAccessToken bool = false
-
-	if _, err = iprot.ReadStructBegin(); err != nil {
-		goto ReadStructBeginError
+func (p *JobDetail) ReadField12(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
 	}
+	_field := make([]*Env, 0, size)
+	values := make([]Env, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
 
-	for {
-		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
-		if err != nil {
-			goto ReadFieldBeginError
-		}
-		if fieldTypeId == thrift.STOP {
-			break
+		if err := _elem.Read(iprot); err != nil {
+			return err
 		}
 
-		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField1(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 2:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 3:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 255:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField255(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetAccessToken = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		default:
-			if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		}
-		if err = iprot.ReadFieldEnd(); err != nil {
-			goto ReadFieldEndError
-		}
+		_field = append(_field, _elem)
 	}
-	if err = iprot.ReadStructEnd(); err != nil {
-		goto ReadStructEndError
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
 	}
+	p.Envs = _field
+	return nil
+}
+func (p *JobDetail) ReadField13(iprot thrift.TProtocol) error {
 
-	if !issetAccessToken {
-		fieldId = 255
-		goto RequiredFieldNotSetError
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
+	p.FailureReason = _field
 	return nil
-ReadStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
-ReadFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_QueryJobRequest[fieldId]), err)
-SkipFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-
-ReadFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
-ReadStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_QueryJobRequest[fieldId]))
 }
+func (p *JobDetail) ReadField14(iprot thrift.TProtocol) error {
 
-func (p *QueryJobRequest) ReadField1(iprot thrift.TProtocol) error {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.FailureDetail = _field
+	return nil
+}
+func (p *JobDetail) ReadField15(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -1369,10 +1495,18 @@ func (p *QueryJobRequest) ReadField1(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.Page = _field
+	p.Timeout = _field
 	return nil
 }
-func (p *QueryJobRequest) ReadField2(iprot thrift.TProtocol) error {
+func (p *JobDetail) ReadField16(iprot thrift.TProtocol) error {
+	_field := NewResourceProfile()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Resources = _field
+	return nil
+}
+func (p *JobDetail) ReadField17(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -1380,10 +1514,10 @@ func (p *QueryJobRequest) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.PageSize = _field
+	p.Priority = _field
 	return nil
 }
-func (p *QueryJobRequest) ReadField3(iprot thrift.TProtocol) error {
+func (p *JobDetail) ReadField18(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -1391,10 +1525,10 @@ func (p *QueryJobRequest) ReadField3(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.Creator = _field
+	p.AttestationAudience = _field
 	return nil
 }
-func (p *QueryJobRequest) ReadField255(iprot thrift.TProtocol) error {
+func (p *JobDetail) ReadField19(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -1402,14 +1536,14 @@ func (p *QueryJobRequest) ReadField255(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.AccessToken = _field
+	p.AttestationTokenType = _field
 	return nil
 }
 
-func (p *QueryJobRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *JobDetail) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("QueryJobRequest"); err != nil {
+	if err = oprot.WriteStructBegin("JobDetail"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1425,8 +1559,68 @@ func (p *QueryJobRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
-		if err = p.writeField255(oprot); err != nil {
-			fieldId = 255
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
+		if err = p.writeField12(oprot); err != nil {
+			fieldId = 12
+			goto WriteFieldError
+		}
+		if err = p.writeField13(oprot); err != nil {
+			fieldId = 13
+			goto WriteFieldError
+		}
+		if err = p.writeField14(oprot); err != nil {
+			fieldId = 14
+			goto WriteFieldError
+		}
+		if err = p.writeField15(oprot); err != nil {
+			fieldId = 15
+			goto WriteFieldError
+		}
+		if err = p.writeField16(oprot); err != nil {
+			fieldId = 16
+			goto WriteFieldError
+		}
+		if err = p.writeField17(oprot); err != nil {
+			fieldId = 17
+			goto WriteFieldError
+		}
+		if err = p.writeField18(oprot); err != nil {
+			fieldId = 18
+			goto WriteFieldError
+		}
+		if err = p.writeField19(oprot); err != nil {
+			fieldId = 19
 			goto WriteFieldError
 		}
 	}
@@ -1447,11 +1641,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *QueryJobRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("page", thrift.I64, 1); err != nil {
+func (p *JobDetail) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.Page); err != nil {
+	if err := oprot.WriteI64(p.ID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -1464,11 +1658,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *QueryJobRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("page_size", thrift.I64, 2); err != nil {
+func (p *JobDetail) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("uuid", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.PageSize); err != nil {
+	if err := oprot.WriteString(p.UUID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -1481,7 +1675,7 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *QueryJobRequest) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *JobDetail) writeField3(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("creator", thrift.STRING, 3); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -1498,11 +1692,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *QueryJobRequest) writeField255(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("access_token", thrift.STRING, 255); err != nil {
+func (p *JobDetail) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("job_status", thrift.I32, 4); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.AccessToken); err != nil {
+	if err := oprot.WriteI32(int32(p.JobStatus)); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -1510,60 +1704,351 @@ func (p *QueryJobRequest) writeField255(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *QueryJobRequest) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *JobDetail) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("jupyter_file_name", thrift.STRING, 5); err != nil {
+		goto WriteFieldBeginError
 	}
-	return fmt.Sprintf("QueryJobRequest(%+v)", *p)
-
+	if err := oprot.WriteString(p.JupyterFileName); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-type QueryJobResponse struct {
-	Code  int32  `thrift:"code,1" form:"code" json:"code" query:"code"`
-	Msg   string `thrift:"msg,2" form:"msg" json:"msg" query:"msg"`
-	Jobs  []*Job `thrift:"jobs,3" form:"jobs" json:"jobs" query:"jobs"`
-	Total int64  `thrift:"total,4" form:"total" json:"total" query:"total"`
+func (p *JobDetail) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("created_at", thrift.STRING, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.CreatedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
 
-func NewQueryJobResponse() *QueryJobResponse {
-	return &QueryJobResponse{}
-}
+func (p *JobDetail) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("updated_at", thrift.STRING, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.UpdatedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
 
-func (p *QueryJobResponse) InitDefault() {
+func (p *JobDetail) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("docker_image", thrift.STRING, 8); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.DockerImage); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
 }
 
-func (p *QueryJobResponse) GetCode() (v int32) {
-	return p.Code
+func (p *JobDetail) writeField9(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("docker_image_digest", thrift.STRING, 9); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.DockerImageDigest); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
 }
 
-func (p *QueryJobResponse) GetMsg() (v string) {
-	return p.Msg
+func (p *JobDetail) writeField10(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("instance_name", thrift.STRING, 10); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.InstanceName); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
 }
 
-func (p *QueryJobResponse) GetJobs() (v []*Job) {
-	return p.Jobs
+func (p *JobDetail) writeField11(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("dockerfile", thrift.STRING, 11); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Dockerfile); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
 }
 
-func (p *QueryJobResponse) GetTotal() (v int64) {
-	return p.Total
+func (p *JobDetail) writeField12(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("envs", thrift.LIST, 12); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Envs)); err != nil {
+		return err
+	}
+	for _, v := range p.Envs {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
 }
 
-var fieldIDToName_QueryJobResponse = map[int16]string{
-	1: "code",
-	2: "msg",
-	3: "jobs",
-	4: "total",
+func (p *JobDetail) writeField13(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("failure_reason", thrift.STRING, 13); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.FailureReason); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 end error: ", p), err)
 }
 
-func (p *QueryJobResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *JobDetail) writeField14(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("failure_detail", thrift.STRING, 14); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.FailureDetail); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 14 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 14 end error: ", p), err)
+}
+
+func (p *JobDetail) writeField15(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("timeout", thrift.I64, 15); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.Timeout); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 15 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 15 end error: ", p), err)
+}
+
+func (p *JobDetail) writeField16(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("resources", thrift.STRUCT, 16); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Resources.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 16 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 16 end error: ", p), err)
+}
+
+func (p *JobDetail) writeField17(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("priority", thrift.I64, 17); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.Priority); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 17 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 17 end error: ", p), err)
+}
+
+func (p *JobDetail) writeField18(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("attestation_audience", thrift.STRING, 18); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.AttestationAudience); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 18 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 18 end error: ", p), err)
+}
+
+func (p *JobDetail) writeField19(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("attestation_token_type", thrift.STRING, 19); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.AttestationTokenType); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 19 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 19 end error: ", p), err)
+}
+
+func (p *JobDetail) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JobDetail(%+v)", *p)
+
+}
+
+type SubmitJobRequest struct {
+	JupyterFileName string           `thrift:"jupyter_file_name,1" form:"filename" json:"filename" vd:"len($) > 0 && len($) < 128 && regexp('^.*\\.ipynb$') && !regexp('.*\\.\\..*')"`
+	Creator         string           `thrift:"creator,2" form:"creator" json:"creator" vd:"len($) > 0 && len($) < 32 && !regexp('.*\\.\\..*')"`
+	Envs            []*Env           `thrift:"envs,3" form:"envs" json:"envs"`
+	Timeout         int64            `thrift:"timeout,4" form:"timeout" json:"timeout" vd:"$ >= 0"`
+	Resources       *ResourceProfile `thrift:"resources,5" form:"resources" json:"resources"`
+	Priority        int64            `thrift:"priority,6" form:"priority" json:"priority" vd:"$ >= 0"`
+	AccessToken     string           `thrift:"access_token,255,required" header:"Authorization,required" json:"access_token,required"`
+}
+
+func NewSubmitJobRequest() *SubmitJobRequest {
+	return &SubmitJobRequest{}
+}
+
+func (p *SubmitJobRequest) InitDefault() {
+}
+
+func (p *SubmitJobRequest) GetJupyterFileName() (v string) {
+	return p.JupyterFileName
+}
+
+func (p *SubmitJobRequest) GetCreator() (v string) {
+	return p.Creator
+}
+
+func (p *SubmitJobRequest) GetEnvs() (v []*Env) {
+	return p.Envs
+}
+
+func (p *SubmitJobRequest) GetTimeout() (v int64) {
+	return p.Timeout
+}
+
+var SubmitJobRequest_Resources_DEFAULT *ResourceProfile
+
+func (p *SubmitJobRequest) GetResources() (v *ResourceProfile) {
+	if !p.IsSetResources() {
+		return SubmitJobRequest_Resources_DEFAULT
+	}
+	return p.Resources
+}
+
+func (p *SubmitJobRequest) GetPriority() (v int64) {
+	return p.Priority
+}
+
+func (p *SubmitJobRequest) GetAccessToken() (v string) {
+	return p.AccessToken
+}
+
+var fieldIDToName_SubmitJobRequest = map[int16]string{
+	1:   "jupyter_file_name",
+	2:   "creator",
+	3:   "envs",
+	4:   "timeout",
+	5:   "resources",
+	6:   "priority",
+	255: "access_token",
+}
+
+func (p *SubmitJobRequest) IsSetResources() bool {
+	return p.Resources != nil
+}
+
+func (p *SubmitJobRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
+	var issetAccessToken bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -1580,7 +2065,7 @@ func (p *QueryJobResponse) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -1611,6 +2096,31 @@ func (p *QueryJobResponse) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 5:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetAccessToken = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -1624,13 +2134,17 @@ func (p *QueryJobResponse) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
+	if !issetAccessToken {
+		fieldId = 255
+		goto RequiredFieldNotSetError
+	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_QueryJobResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SubmitJobRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1638,20 +2152,22 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-}
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_SubmitJobRequest[fieldId]))
+}
 
-func (p *QueryJobResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *SubmitJobRequest) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field int32
-	if v, err := iprot.ReadI32(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.Code = _field
+	p.JupyterFileName = _field
 	return nil
 }
-func (p *QueryJobResponse) ReadField2(iprot thrift.TProtocol) error {
+func (p *SubmitJobRequest) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -1659,16 +2175,16 @@ func (p *QueryJobResponse) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.Msg = _field
+	p.Creator = _field
 	return nil
 }
-func (p *QueryJobResponse) ReadField3(iprot thrift.TProtocol) error {
+func (p *SubmitJobRequest) ReadField3(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return err
 	}
-	_field := make([]*Job, 0, size)
-	values := make([]Job, size)
+	_field := make([]*Env, 0, size)
+	values := make([]Env, size)
 	for i := 0; i < size; i++ {
 		_elem := &values[i]
 		_elem.InitDefault()
@@ -1682,10 +2198,10 @@ func (p *QueryJobResponse) ReadField3(iprot thrift.TProtocol) error {
 	if err := iprot.ReadListEnd(); err != nil {
 		return err
 	}
-	p.Jobs = _field
+	p.Envs = _field
 	return nil
 }
-func (p *QueryJobResponse) ReadField4(iprot thrift.TProtocol) error {
+func (p *SubmitJobRequest) ReadField4(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -1693,14 +2209,44 @@ func (p *QueryJobResponse) ReadField4(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.Total = _field
+	p.Timeout = _field
+	return nil
+}
+func (p *SubmitJobRequest) ReadField5(iprot thrift.TProtocol) error {
+	_field := NewResourceProfile()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Resources = _field
 	return nil
 }
+func (p *SubmitJobRequest) ReadField6(iprot thrift.TProtocol) error {
 
-func (p *QueryJobResponse) Write(oprot thrift.TProtocol) (err error) {
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Priority = _field
+	return nil
+}
+func (p *SubmitJobRequest) ReadField255(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.AccessToken = _field
+	return nil
+}
+
+func (p *SubmitJobRequest) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("QueryJobResponse"); err != nil {
+	if err = oprot.WriteStructBegin("SubmitJobRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1720,6 +2266,18 @@ func (p *QueryJobResponse) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 4
 			goto WriteFieldError
 		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -1738,11 +2296,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *QueryJobResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("code", thrift.I32, 1); err != nil {
+func (p *SubmitJobRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("jupyter_file_name", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.Code); err != nil {
+	if err := oprot.WriteString(p.JupyterFileName); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -1755,11 +2313,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *QueryJobResponse) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("msg", thrift.STRING, 2); err != nil {
+func (p *SubmitJobRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("creator", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Msg); err != nil {
+	if err := oprot.WriteString(p.Creator); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -1772,14 +2330,14 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *QueryJobResponse) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("jobs", thrift.LIST, 3); err != nil {
+func (p *SubmitJobRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("envs", thrift.LIST, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Jobs)); err != nil {
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Envs)); err != nil {
 		return err
 	}
-	for _, v := range p.Jobs {
+	for _, v := range p.Envs {
 		if err := v.Write(oprot); err != nil {
 			return err
 		}
@@ -1797,11 +2355,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *QueryJobResponse) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("total", thrift.I64, 4); err != nil {
+func (p *SubmitJobRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("timeout", thrift.I64, 4); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.Total); err != nil {
+	if err := oprot.WriteI64(p.Timeout); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -1814,50 +2372,100 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *QueryJobResponse) String() string {
+func (p *SubmitJobRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("resources", thrift.STRUCT, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Resources.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *SubmitJobRequest) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("priority", thrift.I64, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.Priority); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *SubmitJobRequest) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("access_token", thrift.STRING, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.AccessToken); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *SubmitJobRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("QueryJobResponse(%+v)", *p)
+	return fmt.Sprintf("SubmitJobRequest(%+v)", *p)
 
 }
 
-type DeleteJobRequest struct {
-	UUID        string `thrift:"uuid,1" form:"uuid" json:"uuid" query:"uuid"`
-	Creator     string `thrift:"creator,2" form:"creator" json:"creator" vd:"len($) > 0 && len($) < 32 && !regexp('.*\\.\\..*')"`
-	AccessToken string `thrift:"access_token,255,required" header:"Authorization,required" json:"access_token,required"`
+type SubmitJobResponse struct {
+	Code int32  `thrift:"code,1" form:"code" json:"code" query:"code"`
+	Msg  string `thrift:"msg,2" form:"msg" json:"msg" query:"msg"`
+	UUID string `thrift:"uuid,3" form:"uuid" json:"uuid" query:"uuid"`
 }
 
-func NewDeleteJobRequest() *DeleteJobRequest {
-	return &DeleteJobRequest{}
+func NewSubmitJobResponse() *SubmitJobResponse {
+	return &SubmitJobResponse{}
 }
 
-func (p *DeleteJobRequest) InitDefault() {
+func (p *SubmitJobResponse) InitDefault() {
 }
 
-func (p *DeleteJobRequest) GetUUID() (v string) {
-	return p.UUID
+func (p *SubmitJobResponse) GetCode() (v int32) {
+	return p.Code
 }
 
-func (p *DeleteJobRequest) GetCreator() (v string) {
-	return p.Creator
+func (p *SubmitJobResponse) GetMsg() (v string) {
+	return p.Msg
 }
 
-func (p *DeleteJobRequest) GetAccessToken() (v string) {
-	return p.AccessToken
+func (p *SubmitJobResponse) GetUUID() (v string) {
+	return p.UUID
 }
 
-var fieldIDToName_DeleteJobRequest = map[int16]string{
-	1:   "uuid",
-	2:   "creator",
-	255: "access_token",
+var fieldIDToName_SubmitJobResponse = map[int16]string{
+	1: "code",
+	2: "msg",
+	3: "uuid",
 }
 
-func (p *DeleteJobRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *SubmitJobResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetAccessToken bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -1874,7 +2482,7 @@ func (p *DeleteJobRequest) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -1889,12 +2497,11 @@ func (p *DeleteJobRequest) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 255:
+		case 3:
 			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField255(iprot); err != nil {
+				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetAccessToken = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -1911,17 +2518,13 @@ func (p *DeleteJobRequest) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetAccessToken {
-		fieldId = 255
-		goto RequiredFieldNotSetError
-	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteJobRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SubmitJobResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1929,22 +2532,20 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_DeleteJobRequest[fieldId]))
 }
 
-func (p *DeleteJobRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *SubmitJobResponse) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.UUID = _field
+	p.Code = _field
 	return nil
 }
-func (p *DeleteJobRequest) ReadField2(iprot thrift.TProtocol) error {
+func (p *SubmitJobResponse) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -1952,10 +2553,10 @@ func (p *DeleteJobRequest) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.Creator = _field
+	p.Msg = _field
 	return nil
 }
-func (p *DeleteJobRequest) ReadField255(iprot thrift.TProtocol) error {
+func (p *SubmitJobResponse) ReadField3(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -1963,14 +2564,14 @@ func (p *DeleteJobRequest) ReadField255(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.AccessToken = _field
+	p.UUID = _field
 	return nil
 }
 
-func (p *DeleteJobRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *SubmitJobResponse) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeleteJobRequest"); err != nil {
+	if err = oprot.WriteStructBegin("SubmitJobResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1982,8 +2583,8 @@ func (p *DeleteJobRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
-		if err = p.writeField255(oprot); err != nil {
-			fieldId = 255
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
 			goto WriteFieldError
 		}
 	}
@@ -2004,11 +2605,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DeleteJobRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("uuid", thrift.STRING, 1); err != nil {
+func (p *SubmitJobResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("code", thrift.I32, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.UUID); err != nil {
+	if err := oprot.WriteI32(p.Code); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2021,11 +2622,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *DeleteJobRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("creator", thrift.STRING, 2); err != nil {
+func (p *SubmitJobResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("msg", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Creator); err != nil {
+	if err := oprot.WriteString(p.Msg); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2038,11 +2639,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *DeleteJobRequest) writeField255(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("access_token", thrift.STRING, 255); err != nil {
+func (p *SubmitJobResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("uuid", thrift.STRING, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.AccessToken); err != nil {
+	if err := oprot.WriteString(p.UUID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2050,48 +2651,61 @@ func (p *DeleteJobRequest) writeField255(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *DeleteJobRequest) String() string {
+func (p *SubmitJobResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DeleteJobRequest(%+v)", *p)
+	return fmt.Sprintf("SubmitJobResponse(%+v)", *p)
 
 }
 
-type DeleteJobResponse struct {
-	Code int32  `thrift:"code,1" form:"code" json:"code" query:"code"`
-	Msg  string `thrift:"msg,2" form:"msg" json:"msg" query:"msg"`
+type QueryJobRequest struct {
+	Page        int64  `thrift:"page,1" form:"page" json:"page" query:"page" vd:"$>0"`
+	PageSize    int64  `thrift:"page_size,2" form:"page_size" json:"page_size" query:"page_size" vd:"$ > 0 || $ <= 100"`
+	Creator     string `thrift:"creator,3" form:"creator" json:"creator" vd:"len($) > 0 && len($) < 32 && !regexp('.*\\.\\..*')"`
+	AccessToken string `thrift:"access_token,255,required" header:"Authorization,required" json:"access_token,required"`
 }
 
-func NewDeleteJobResponse() *DeleteJobResponse {
-	return &DeleteJobResponse{}
+func NewQueryJobRequest() *QueryJobRequest {
+	return &QueryJobRequest{}
 }
 
-func (p *DeleteJobResponse) InitDefault() {
+func (p *QueryJobRequest) InitDefault() {
 }
 
-func (p *DeleteJobResponse) GetCode() (v int32) {
-	return p.Code
+func (p *QueryJobRequest) GetPage() (v int64) {
+	return p.Page
 }
 
-func (p *DeleteJobResponse) GetMsg() (v string) {
-	return p.Msg
+func (p *QueryJobRequest) GetPageSize() (v int64) {
+	return p.PageSize
 }
 
-var fieldIDToName_DeleteJobResponse = map[int16]string{
-	1: "code",
-	2: "msg",
+func (p *QueryJobRequest) GetCreator() (v string) {
+	return p.Creator
 }
 
-func (p *DeleteJobResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *QueryJobRequest) GetAccessToken() (v string) {
+	return p.AccessToken
+}
+
+var fieldIDToName_QueryJobRequest = map[int16]string{
+	1:   "page",
+	2:   "page_size",
+	3:   "creator",
+	255: "access_token",
+}
+
+func (p *QueryJobRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
+	var issetAccessToken bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -2108,7 +2722,7 @@ func (p *DeleteJobResponse) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -2116,13 +2730,30 @@ func (p *DeleteJobResponse) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetAccessToken = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -2136,13 +2767,17 @@ func (p *DeleteJobResponse) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
+	if !issetAccessToken {
+		fieldId = 255
+		goto RequiredFieldNotSetError
+	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteJobResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_QueryJobRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2150,20 +2785,33 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_QueryJobRequest[fieldId]))
 }
 
-func (p *DeleteJobResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *QueryJobRequest) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field int32
-	if v, err := iprot.ReadI32(); err != nil {
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.Code = _field
+	p.Page = _field
 	return nil
 }
-func (p *DeleteJobResponse) ReadField2(iprot thrift.TProtocol) error {
+func (p *QueryJobRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.PageSize = _field
+	return nil
+}
+func (p *QueryJobRequest) ReadField3(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -2171,14 +2819,25 @@ func (p *DeleteJobResponse) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.Msg = _field
+	p.Creator = _field
 	return nil
 }
+func (p *QueryJobRequest) ReadField255(iprot thrift.TProtocol) error {
 
-func (p *DeleteJobResponse) Write(oprot thrift.TProtocol) (err error) {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.AccessToken = _field
+	return nil
+}
+
+func (p *QueryJobRequest) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeleteJobResponse"); err != nil {
+	if err = oprot.WriteStructBegin("QueryJobRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2190,6 +2849,14 @@ func (p *DeleteJobResponse) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -2208,11 +2875,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DeleteJobResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("code", thrift.I32, 1); err != nil {
+func (p *QueryJobRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("page", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.Code); err != nil {
+	if err := oprot.WriteI64(p.Page); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2225,11 +2892,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *DeleteJobResponse) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("msg", thrift.STRING, 2); err != nil {
+func (p *QueryJobRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("page_size", thrift.I64, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Msg); err != nil {
+	if err := oprot.WriteI64(p.PageSize); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2242,50 +2909,89 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *DeleteJobResponse) String() string {
+func (p *QueryJobRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("creator", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Creator); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *QueryJobRequest) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("access_token", thrift.STRING, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.AccessToken); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *QueryJobRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DeleteJobResponse(%+v)", *p)
+	return fmt.Sprintf("QueryJobRequest(%+v)", *p)
 
 }
 
-type DownloadJobOutputRequest struct {
-	ID          int64  `thrift:"id,1" form:"id" json:"id" query:"id" vd:"$>0"`
-	Creator     string `thrift:"creator,2" form:"creator" json:"creator" vd:"len($) > 0 && len($) < 32 && !regexp('.*\\.\\..*')"`
-	AccessToken string `thrift:"access_token,255,required" header:"Authorization,required" json:"access_token,required"`
+type QueryJobResponse struct {
+	Code  int32  `thrift:"code,1" form:"code" json:"code" query:"code"`
+	Msg   string `thrift:"msg,2" form:"msg" json:"msg" query:"msg"`
+	Jobs  []*Job `thrift:"jobs,3" form:"jobs" json:"jobs" query:"jobs"`
+	Total int64  `thrift:"total,4" form:"total" json:"total" query:"total"`
 }
 
-func NewDownloadJobOutputRequest() *DownloadJobOutputRequest {
-	return &DownloadJobOutputRequest{}
+func NewQueryJobResponse() *QueryJobResponse {
+	return &QueryJobResponse{}
 }
 
-func (p *DownloadJobOutputRequest) InitDefault() {
+func (p *QueryJobResponse) InitDefault() {
 }
 
-func (p *DownloadJobOutputRequest) GetID() (v int64) {
-	return p.ID
+func (p *QueryJobResponse) GetCode() (v int32) {
+	return p.Code
 }
 
-func (p *DownloadJobOutputRequest) GetCreator() (v string) {
-	return p.Creator
+func (p *QueryJobResponse) GetMsg() (v string) {
+	return p.Msg
 }
 
-func (p *DownloadJobOutputRequest) GetAccessToken() (v string) {
-	return p.AccessToken
+func (p *QueryJobResponse) GetJobs() (v []*Job) {
+	return p.Jobs
 }
 
-var fieldIDToName_DownloadJobOutputRequest = map[int16]string{
-	1:   "id",
-	2:   "creator",
-	255: "access_token",
+func (p *QueryJobResponse) GetTotal() (v int64) {
+	return p.Total
 }
 
-func (p *DownloadJobOutputRequest) Read(iprot thrift.TProtocol) (err error) {
+var fieldIDToName_QueryJobResponse = map[int16]string{
+	1: "code",
+	2: "msg",
+	3: "jobs",
+	4: "total",
+}
+
+func (p *QueryJobResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetAccessToken bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -2302,7 +3008,7 @@ func (p *DownloadJobOutputRequest) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -2317,12 +3023,19 @@ func (p *DownloadJobOutputRequest) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 255:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField255(iprot); err != nil {
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetAccessToken = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -2339,17 +3052,13 @@ func (p *DownloadJobOutputRequest) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetAccessToken {
-		fieldId = 255
-		goto RequiredFieldNotSetError
-	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DownloadJobOutputRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_QueryJobResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2357,22 +3066,20 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_DownloadJobOutputRequest[fieldId]))
 }
 
-func (p *DownloadJobOutputRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *QueryJobResponse) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.ID = _field
+	p.Code = _field
 	return nil
 }
-func (p *DownloadJobOutputRequest) ReadField2(iprot thrift.TProtocol) error {
+func (p *QueryJobResponse) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -2380,25 +3087,48 @@ func (p *DownloadJobOutputRequest) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.Creator = _field
+	p.Msg = _field
 	return nil
 }
-func (p *DownloadJobOutputRequest) ReadField255(iprot thrift.TProtocol) error {
+func (p *QueryJobResponse) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*Job, 0, size)
+	values := make([]Job, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.AccessToken = _field
+	p.Jobs = _field
 	return nil
 }
+func (p *QueryJobResponse) ReadField4(iprot thrift.TProtocol) error {
 
-func (p *DownloadJobOutputRequest) Write(oprot thrift.TProtocol) (err error) {
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Total = _field
+	return nil
+}
+
+func (p *QueryJobResponse) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DownloadJobOutputRequest"); err != nil {
+	if err = oprot.WriteStructBegin("QueryJobResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2410,8 +3140,12 @@ func (p *DownloadJobOutputRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
-		if err = p.writeField255(oprot); err != nil {
-			fieldId = 255
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
 			goto WriteFieldError
 		}
 	}
@@ -2432,11 +3166,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DownloadJobOutputRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+func (p *QueryJobResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("code", thrift.I32, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.ID); err != nil {
+	if err := oprot.WriteI32(p.Code); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2449,11 +3183,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *DownloadJobOutputRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("creator", thrift.STRING, 2); err != nil {
+func (p *QueryJobResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("msg", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Creator); err != nil {
+	if err := oprot.WriteString(p.Msg); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2466,11 +3200,19 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *DownloadJobOutputRequest) writeField255(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("access_token", thrift.STRING, 255); err != nil {
+func (p *QueryJobResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("jobs", thrift.LIST, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.AccessToken); err != nil {
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Jobs)); err != nil {
+		return err
+	}
+	for _, v := range p.Jobs {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2478,60 +3220,72 @@ func (p *DownloadJobOutputRequest) writeField255(oprot thrift.TProtocol) (err er
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *DownloadJobOutputRequest) String() string {
+func (p *QueryJobResponse) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("total", thrift.I64, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.Total); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *QueryJobResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DownloadJobOutputRequest(%+v)", *p)
-
-}
+	return fmt.Sprintf("QueryJobResponse(%+v)", *p)
 
-type DownloadJobOutputResponse struct {
-	Code      int32  `thrift:"code,1" form:"code" json:"code" query:"code"`
-	Msg       string `thrift:"msg,2" form:"msg" json:"msg" query:"msg"`
-	SignedURL string `thrift:"signed_url,3" form:"signed_url" json:"signed_url" query:"signed_url"`
-	Filename  string `thrift:"filename,4" form:"filename" json:"filename" query:"filename"`
 }
 
-func NewDownloadJobOutputResponse() *DownloadJobOutputResponse {
-	return &DownloadJobOutputResponse{}
+type DeleteJobRequest struct {
+	UUID        string `thrift:"uuid,1" form:"uuid" json:"uuid" query:"uuid"`
+	Creator     string `thrift:"creator,2" form:"creator" json:"creator" vd:"len($) > 0 && len($) < 32 && !regexp('.*\\.\\..*')"`
+	AccessToken string `thrift:"access_token,255,required" header:"Authorization,required" json:"access_token,required"`
 }
 
-func (p *DownloadJobOutputResponse) InitDefault() {
+func NewDeleteJobRequest() *DeleteJobRequest {
+	return &DeleteJobRequest{}
 }
 
-func (p *DownloadJobOutputResponse) GetCode() (v int32) {
-	return p.Code
+func (p *DeleteJobRequest) InitDefault() {
 }
 
-func (p *DownloadJobOutputResponse) GetMsg() (v string) {
-	return p.Msg
+func (p *DeleteJobRequest) GetUUID() (v string) {
+	return p.UUID
 }
 
-func (p *DownloadJobOutputResponse) GetSignedURL() (v string) {
-	return p.SignedURL
+func (p *DeleteJobRequest) GetCreator() (v string) {
+	return p.Creator
 }
 
-func (p *DownloadJobOutputResponse) GetFilename() (v string) {
-	return p.Filename
+func (p *DeleteJobRequest) GetAccessToken() (v string) {
+	return p.AccessToken
 }
 
-var fieldIDToName_DownloadJobOutputResponse = map[int16]string{
-	1: "code",
-	2: "msg",
-	3: "signed_url",
-	4: "filename",
+var fieldIDToName_DeleteJobRequest = map[int16]string{
+	1:   "uuid",
+	2:   "creator",
+	255: "access_token",
 }
 
-func (p *DownloadJobOutputResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *DeleteJobRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
+	var issetAccessToken bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -2548,7 +3302,7 @@ func (p *DownloadJobOutputResponse) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -2563,19 +3317,12 @@ func (p *DownloadJobOutputResponse) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 3:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 4:
+		case 255:
 			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField4(iprot); err != nil {
+				if err = p.ReadField255(iprot); err != nil {
 					goto ReadFieldError
 				}
+				issetAccessToken = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -2592,13 +3339,17 @@ func (p *DownloadJobOutputResponse) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
+	if !issetAccessToken {
+		fieldId = 255
+		goto RequiredFieldNotSetError
+	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DownloadJobOutputResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteJobRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2606,20 +3357,11 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_DeleteJobRequest[fieldId]))
 }
 
-func (p *DownloadJobOutputResponse) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field int32
-	if v, err := iprot.ReadI32(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.Code = _field
-	return nil
-}
-func (p *DownloadJobOutputResponse) ReadField2(iprot thrift.TProtocol) error {
+func (p *DeleteJobRequest) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -2627,10 +3369,10 @@ func (p *DownloadJobOutputResponse) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.Msg = _field
+	p.UUID = _field
 	return nil
 }
-func (p *DownloadJobOutputResponse) ReadField3(iprot thrift.TProtocol) error {
+func (p *DeleteJobRequest) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -2638,10 +3380,10 @@ func (p *DownloadJobOutputResponse) ReadField3(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.SignedURL = _field
+	p.Creator = _field
 	return nil
 }
-func (p *DownloadJobOutputResponse) ReadField4(iprot thrift.TProtocol) error {
+func (p *DeleteJobRequest) ReadField255(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -2649,14 +3391,14 @@ func (p *DownloadJobOutputResponse) ReadField4(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.Filename = _field
+	p.AccessToken = _field
 	return nil
 }
 
-func (p *DownloadJobOutputResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *DeleteJobRequest) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DownloadJobOutputResponse"); err != nil {
+	if err = oprot.WriteStructBegin("DeleteJobRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2668,12 +3410,8 @@ func (p *DownloadJobOutputResponse) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
 			goto WriteFieldError
 		}
 	}
@@ -2694,11 +3432,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DownloadJobOutputResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("code", thrift.I32, 1); err != nil {
+func (p *DeleteJobRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("uuid", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.Code); err != nil {
+	if err := oprot.WriteString(p.UUID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2711,11 +3449,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *DownloadJobOutputResponse) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("msg", thrift.STRING, 2); err != nil {
+func (p *DeleteJobRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("creator", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Msg); err != nil {
+	if err := oprot.WriteString(p.Creator); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2728,28 +3466,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *DownloadJobOutputResponse) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("signed_url", thrift.STRING, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.SignedURL); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
-}
-
-func (p *DownloadJobOutputResponse) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("filename", thrift.STRING, 4); err != nil {
+func (p *DeleteJobRequest) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("access_token", thrift.STRING, 255); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Filename); err != nil {
+	if err := oprot.WriteString(p.AccessToken); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2757,45 +3478,45 @@ func (p *DownloadJobOutputResponse) writeField4(oprot thrift.TProtocol) (err err
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *DownloadJobOutputResponse) String() string {
+func (p *DeleteJobRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DownloadJobOutputResponse(%+v)", *p)
+	return fmt.Sprintf("DeleteJobRequest(%+v)", *p)
 
 }
 
-type QueryJobAttestationRequest struct {
-	ID      int64  `thrift:"id,1" form:"id" json:"id" query:"id" vd:"$>0"`
-	Creator string `thrift:"creator,2" form:"creator" json:"creator" vd:"len($) > 0 && len($) < 32 && !regexp('.*\\.\\..*')"`
+type DeleteJobResponse struct {
+	Code int32  `thrift:"code,1" form:"code" json:"code" query:"code"`
+	Msg  string `thrift:"msg,2" form:"msg" json:"msg" query:"msg"`
 }
 
-func NewQueryJobAttestationRequest() *QueryJobAttestationRequest {
-	return &QueryJobAttestationRequest{}
+func NewDeleteJobResponse() *DeleteJobResponse {
+	return &DeleteJobResponse{}
 }
 
-func (p *QueryJobAttestationRequest) InitDefault() {
+func (p *DeleteJobResponse) InitDefault() {
 }
 
-func (p *QueryJobAttestationRequest) GetID() (v int64) {
-	return p.ID
+func (p *DeleteJobResponse) GetCode() (v int32) {
+	return p.Code
 }
 
-func (p *QueryJobAttestationRequest) GetCreator() (v string) {
-	return p.Creator
+func (p *DeleteJobResponse) GetMsg() (v string) {
+	return p.Msg
 }
 
-var fieldIDToName_QueryJobAttestationRequest = map[int16]string{
-	1: "id",
-	2: "creator",
+var fieldIDToName_DeleteJobResponse = map[int16]string{
+	1: "code",
+	2: "msg",
 }
 
-func (p *QueryJobAttestationRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *DeleteJobResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -2815,7 +3536,7 @@ func (p *QueryJobAttestationRequest) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -2849,7 +3570,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_QueryJobAttestationRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteJobResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2859,18 +3580,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *QueryJobAttestationRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *DeleteJobResponse) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.ID = _field
+	p.Code = _field
 	return nil
 }
-func (p *QueryJobAttestationRequest) ReadField2(iprot thrift.TProtocol) error {
+func (p *DeleteJobResponse) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -2878,14 +3599,14 @@ func (p *QueryJobAttestationRequest) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.Creator = _field
+	p.Msg = _field
 	return nil
 }
 
-func (p *QueryJobAttestationRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *DeleteJobResponse) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("QueryJobAttestationRequest"); err != nil {
+	if err = oprot.WriteStructBegin("DeleteJobResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2915,11 +3636,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *QueryJobAttestationRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+func (p *DeleteJobResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("code", thrift.I32, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.ID); err != nil {
+	if err := oprot.WriteI32(p.Code); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2932,11 +3653,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *QueryJobAttestationRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("creator", thrift.STRING, 2); err != nil {
+func (p *DeleteJobResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("msg", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Creator); err != nil {
+	if err := oprot.WriteString(p.Msg); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2949,49 +3670,50 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *QueryJobAttestationRequest) String() string {
+func (p *DeleteJobResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("QueryJobAttestationRequest(%+v)", *p)
+	return fmt.Sprintf("DeleteJobResponse(%+v)", *p)
 
 }
 
-type QueryJobAttestationResponse struct {
-	Code      int32  `thrift:"code,1" form:"code" json:"code" query:"code"`
-	Msg       string `thrift:"msg,2" form:"msg" json:"msg" query:"msg"`
-	SignedURL string `thrift:"signed_url,3" form:"signed_url" json:"signed_url" query:"signed_url"`
+type CancelJobRequest struct {
+	UUID        string `thrift:"uuid,1" form:"uuid" json:"uuid" query:"uuid" vd:"len($) > 0"`
+	Creator     string `thrift:"creator,2" form:"creator" json:"creator" vd:"len($) > 0 && len($) < 32 && !regexp('.*\\.\\..*')"`
+	AccessToken string `thrift:"access_token,255,required" header:"Authorization,required" json:"access_token,required"`
 }
 
-func NewQueryJobAttestationResponse() *QueryJobAttestationResponse {
-	return &QueryJobAttestationResponse{}
+func NewCancelJobRequest() *CancelJobRequest {
+	return &CancelJobRequest{}
 }
 
-func (p *QueryJobAttestationResponse) InitDefault() {
+func (p *CancelJobRequest) InitDefault() {
 }
 
-func (p *QueryJobAttestationResponse) GetCode() (v int32) {
-	return p.Code
+func (p *CancelJobRequest) GetUUID() (v string) {
+	return p.UUID
 }
 
-func (p *QueryJobAttestationResponse) GetMsg() (v string) {
-	return p.Msg
+func (p *CancelJobRequest) GetCreator() (v string) {
+	return p.Creator
 }
 
-func (p *QueryJobAttestationResponse) GetSignedURL() (v string) {
-	return p.SignedURL
+func (p *CancelJobRequest) GetAccessToken() (v string) {
+	return p.AccessToken
 }
 
-var fieldIDToName_QueryJobAttestationResponse = map[int16]string{
-	1: "code",
-	2: "msg",
-	3: "signed_url",
+var fieldIDToName_CancelJobRequest = map[int16]string{
+	1:   "uuid",
+	2:   "creator",
+	255: "access_token",
 }
 
-func (p *QueryJobAttestationResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *CancelJobRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
+	var issetAccessToken bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -3008,7 +3730,7 @@ func (p *QueryJobAttestationResponse) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -3023,11 +3745,12 @@ func (p *QueryJobAttestationResponse) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 3:
+		case 255:
 			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField3(iprot); err != nil {
+				if err = p.ReadField255(iprot); err != nil {
 					goto ReadFieldError
 				}
+				issetAccessToken = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -3044,13 +3767,17 @@ func (p *QueryJobAttestationResponse) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
+	if !issetAccessToken {
+		fieldId = 255
+		goto RequiredFieldNotSetError
+	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_QueryJobAttestationResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CancelJobRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3058,20 +3785,22 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_CancelJobRequest[fieldId]))
 }
 
-func (p *QueryJobAttestationResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *CancelJobRequest) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field int32
-	if v, err := iprot.ReadI32(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.Code = _field
+	p.UUID = _field
 	return nil
 }
-func (p *QueryJobAttestationResponse) ReadField2(iprot thrift.TProtocol) error {
+func (p *CancelJobRequest) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -3079,10 +3808,10 @@ func (p *QueryJobAttestationResponse) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.Msg = _field
+	p.Creator = _field
 	return nil
 }
-func (p *QueryJobAttestationResponse) ReadField3(iprot thrift.TProtocol) error {
+func (p *CancelJobRequest) ReadField255(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -3090,14 +3819,14 @@ func (p *QueryJobAttestationResponse) ReadField3(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.SignedURL = _field
+	p.AccessToken = _field
 	return nil
 }
 
-func (p *QueryJobAttestationResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *CancelJobRequest) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("QueryJobAttestationResponse"); err != nil {
+	if err = oprot.WriteStructBegin("CancelJobRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3109,8 +3838,8 @@ func (p *QueryJobAttestationResponse) Write(oprot thrift.TProtocol) (err error)
 			fieldId = 2
 			goto WriteFieldError
 		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
 			goto WriteFieldError
 		}
 	}
@@ -3131,11 +3860,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *QueryJobAttestationResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("code", thrift.I32, 1); err != nil {
+func (p *CancelJobRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("uuid", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI32(p.Code); err != nil {
+	if err := oprot.WriteString(p.UUID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3148,11 +3877,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *QueryJobAttestationResponse) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("msg", thrift.STRING, 2); err != nil {
+func (p *CancelJobRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("creator", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Msg); err != nil {
+	if err := oprot.WriteString(p.Creator); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3165,11 +3894,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *QueryJobAttestationResponse) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("signed_url", thrift.STRING, 3); err != nil {
+func (p *CancelJobRequest) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("access_token", thrift.STRING, 255); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.SignedURL); err != nil {
+	if err := oprot.WriteString(p.AccessToken); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3177,417 +3906,7421 @@ func (p *QueryJobAttestationResponse) writeField3(oprot thrift.TProtocol) (err e
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *QueryJobAttestationResponse) String() string {
+func (p *CancelJobRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("QueryJobAttestationResponse(%+v)", *p)
+	return fmt.Sprintf("CancelJobRequest(%+v)", *p)
 
 }
 
-type JobHandler interface {
-	SubmitJob(ctx context.Context, req *SubmitJobRequest) (r *SubmitJobResponse, err error)
-
-	QueryJob(ctx context.Context, req *QueryJobRequest) (r *QueryJobResponse, err error)
-
-	DeleteJob(ctx context.Context, req *DeleteJobRequest) (r *DeleteJobResponse, err error)
-
-	DownloadJobOutput(ctx context.Context, req *DownloadJobOutputRequest) (r *DownloadJobOutputResponse, err error)
-
-	QueryJobAttestationReport(ctx context.Context, req *QueryJobAttestationRequest) (r *QueryJobAttestationResponse, err error)
+type CancelJobResponse struct {
+	Code int32  `thrift:"code,1" form:"code" json:"code" query:"code"`
+	Msg  string `thrift:"msg,2" form:"msg" json:"msg" query:"msg"`
 }
 
-type JobHandlerClient struct {
-	c thrift.TClient
+func NewCancelJobResponse() *CancelJobResponse {
+	return &CancelJobResponse{}
 }
 
-func NewJobHandlerClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *JobHandlerClient {
-	return &JobHandlerClient{
-		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
-	}
+func (p *CancelJobResponse) InitDefault() {
 }
 
-func NewJobHandlerClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *JobHandlerClient {
-	return &JobHandlerClient{
-		c: thrift.NewTStandardClient(iprot, oprot),
-	}
+func (p *CancelJobResponse) GetCode() (v int32) {
+	return p.Code
 }
 
-func NewJobHandlerClient(c thrift.TClient) *JobHandlerClient {
-	return &JobHandlerClient{
-		c: c,
-	}
+func (p *CancelJobResponse) GetMsg() (v string) {
+	return p.Msg
 }
 
-func (p *JobHandlerClient) Client_() thrift.TClient {
-	return p.c
+var fieldIDToName_CancelJobResponse = map[int16]string{
+	1: "code",
+	2: "msg",
 }
 
-func (p *JobHandlerClient) SubmitJob(ctx context.Context, req *SubmitJobRequest) (r *SubmitJobResponse, err error) {
-	var _args JobHandlerSubmitJobArgs
-	_args.Req = req
-	var _result JobHandlerSubmitJobResult
-	if err = p.Client_().Call(ctx, "SubmitJob", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
-}
-func (p *JobHandlerClient) QueryJob(ctx context.Context, req *QueryJobRequest) (r *QueryJobResponse, err error) {
-	var _args JobHandlerQueryJobArgs
-	_args.Req = req
-	var _result JobHandlerQueryJobResult
-	if err = p.Client_().Call(ctx, "QueryJob", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
-}
-func (p *JobHandlerClient) DeleteJob(ctx context.Context, req *DeleteJobRequest) (r *DeleteJobResponse, err error) {
-	var _args JobHandlerDeleteJobArgs
-	_args.Req = req
-	var _result JobHandlerDeleteJobResult
-	if err = p.Client_().Call(ctx, "DeleteJob", &_args, &_result); err != nil {
-		return
+func (p *CancelJobResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *JobHandlerClient) DownloadJobOutput(ctx context.Context, req *DownloadJobOutputRequest) (r *DownloadJobOutputResponse, err error) {
-	var _args JobHandlerDownloadJobOutputArgs
-	_args.Req = req
-	var _result JobHandlerDownloadJobOutputResult
-	if err = p.Client_().Call(ctx, "DownloadJobOutput", &_args, &_result); err != nil {
-		return
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *JobHandlerClient) QueryJobAttestationReport(ctx context.Context, req *QueryJobAttestationRequest) (r *QueryJobAttestationResponse, err error) {
-	var _args JobHandlerQueryJobAttestationReportArgs
-	_args.Req = req
-	var _result JobHandlerQueryJobAttestationReportResult
-	if err = p.Client_().Call(ctx, "QueryJobAttestationReport", &_args, &_result); err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return _result.GetSuccess(), nil
-}
 
-type JobHandlerProcessor struct {
-	processorMap map[string]thrift.TProcessorFunction
-	handler      JobHandler
-}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CancelJobResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
-func (p *JobHandlerProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
-	p.processorMap[key] = processor
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *JobHandlerProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
-	processor, ok = p.processorMap[key]
-	return processor, ok
-}
+func (p *CancelJobResponse) ReadField1(iprot thrift.TProtocol) error {
 
-func (p *JobHandlerProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
-	return p.processorMap
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Code = _field
+	return nil
 }
+func (p *CancelJobResponse) ReadField2(iprot thrift.TProtocol) error {
 
-func NewJobHandlerProcessor(handler JobHandler) *JobHandlerProcessor {
-	self := &JobHandlerProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
-	self.AddToProcessorMap("SubmitJob", &jobHandlerProcessorSubmitJob{handler: handler})
-	self.AddToProcessorMap("QueryJob", &jobHandlerProcessorQueryJob{handler: handler})
-	self.AddToProcessorMap("DeleteJob", &jobHandlerProcessorDeleteJob{handler: handler})
-	self.AddToProcessorMap("DownloadJobOutput", &jobHandlerProcessorDownloadJobOutput{handler: handler})
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Msg = _field
+	return nil
+}
+
+func (p *CancelJobResponse) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CancelJobResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *CancelJobResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Code); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *CancelJobResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Msg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *CancelJobResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CancelJobResponse(%+v)", *p)
+
+}
+
+type QueryJobDetailRequest struct {
+	UUID        string `thrift:"uuid,1" json:"uuid" path:"uuid" vd:"len($) > 0"`
+	Creator     string `thrift:"creator,2" json:"creator" query:"creator" vd:"len($) < 32 && !regexp('.*\\.\\..*')"`
+	AccessToken string `thrift:"access_token,255,required" header:"Authorization,required" json:"access_token,required"`
+}
+
+func NewQueryJobDetailRequest() *QueryJobDetailRequest {
+	return &QueryJobDetailRequest{}
+}
+
+func (p *QueryJobDetailRequest) InitDefault() {
+}
+
+func (p *QueryJobDetailRequest) GetUUID() (v string) {
+	return p.UUID
+}
+
+func (p *QueryJobDetailRequest) GetCreator() (v string) {
+	return p.Creator
+}
+
+func (p *QueryJobDetailRequest) GetAccessToken() (v string) {
+	return p.AccessToken
+}
+
+var fieldIDToName_QueryJobDetailRequest = map[int16]string{
+	1:   "uuid",
+	2:   "creator",
+	255: "access_token",
+}
+
+func (p *QueryJobDetailRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetAccessToken bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetAccessToken = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetAccessToken {
+		fieldId = 255
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_QueryJobDetailRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_QueryJobDetailRequest[fieldId]))
+}
+
+func (p *QueryJobDetailRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UUID = _field
+	return nil
+}
+func (p *QueryJobDetailRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Creator = _field
+	return nil
+}
+func (p *QueryJobDetailRequest) ReadField255(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.AccessToken = _field
+	return nil
+}
+
+func (p *QueryJobDetailRequest) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("QueryJobDetailRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *QueryJobDetailRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("uuid", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.UUID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *QueryJobDetailRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("creator", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Creator); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *QueryJobDetailRequest) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("access_token", thrift.STRING, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.AccessToken); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *QueryJobDetailRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("QueryJobDetailRequest(%+v)", *p)
+
+}
+
+type QueryJobDetailResponse struct {
+	Code int32      `thrift:"code,1" form:"code" json:"code" query:"code"`
+	Msg  string     `thrift:"msg,2" form:"msg" json:"msg" query:"msg"`
+	Job  *JobDetail `thrift:"job,3" form:"job" json:"job" query:"job"`
+}
+
+func NewQueryJobDetailResponse() *QueryJobDetailResponse {
+	return &QueryJobDetailResponse{}
+}
+
+func (p *QueryJobDetailResponse) InitDefault() {
+}
+
+func (p *QueryJobDetailResponse) GetCode() (v int32) {
+	return p.Code
+}
+
+func (p *QueryJobDetailResponse) GetMsg() (v string) {
+	return p.Msg
+}
+
+var QueryJobDetailResponse_Job_DEFAULT *JobDetail
+
+func (p *QueryJobDetailResponse) GetJob() (v *JobDetail) {
+	if !p.IsSetJob() {
+		return QueryJobDetailResponse_Job_DEFAULT
+	}
+	return p.Job
+}
+
+var fieldIDToName_QueryJobDetailResponse = map[int16]string{
+	1: "code",
+	2: "msg",
+	3: "job",
+}
+
+func (p *QueryJobDetailResponse) IsSetJob() bool {
+	return p.Job != nil
+}
+
+func (p *QueryJobDetailResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_QueryJobDetailResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *QueryJobDetailResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Code = _field
+	return nil
+}
+func (p *QueryJobDetailResponse) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Msg = _field
+	return nil
+}
+func (p *QueryJobDetailResponse) ReadField3(iprot thrift.TProtocol) error {
+	_field := NewJobDetail()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Job = _field
+	return nil
+}
+
+func (p *QueryJobDetailResponse) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("QueryJobDetailResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *QueryJobDetailResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Code); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *QueryJobDetailResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Msg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *QueryJobDetailResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("job", thrift.STRUCT, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Job.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *QueryJobDetailResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("QueryJobDetailResponse(%+v)", *p)
+
+}
+
+type JobEvent struct {
+	OldStatus JobStatus `thrift:"old_status,1" form:"old_status" json:"old_status" query:"old_status"`
+	NewStatus JobStatus `thrift:"new_status,2" form:"new_status" json:"new_status" query:"new_status"`
+	Reason    string    `thrift:"reason,3" form:"reason" json:"reason" query:"reason"`
+	CreatedAt string    `thrift:"created_at,4" form:"created_at" json:"created_at" query:"created_at"`
+}
+
+func NewJobEvent() *JobEvent {
+	return &JobEvent{}
+}
+
+func (p *JobEvent) InitDefault() {
+}
+
+func (p *JobEvent) GetOldStatus() (v JobStatus) {
+	return p.OldStatus
+}
+
+func (p *JobEvent) GetNewStatus() (v JobStatus) {
+	return p.NewStatus
+}
+
+func (p *JobEvent) GetReason() (v string) {
+	return p.Reason
+}
+
+func (p *JobEvent) GetCreatedAt() (v string) {
+	return p.CreatedAt
+}
+
+var fieldIDToName_JobEvent = map[int16]string{
+	1: "old_status",
+	2: "new_status",
+	3: "reason",
+	4: "created_at",
+}
+
+func (p *JobEvent) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobEvent[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *JobEvent) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field JobStatus
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = JobStatus(v)
+	}
+	p.OldStatus = _field
+	return nil
+}
+func (p *JobEvent) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field JobStatus
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = JobStatus(v)
+	}
+	p.NewStatus = _field
+	return nil
+}
+func (p *JobEvent) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Reason = _field
+	return nil
+}
+func (p *JobEvent) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CreatedAt = _field
+	return nil
+}
+
+func (p *JobEvent) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("JobEvent"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *JobEvent) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("old_status", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(int32(p.OldStatus)); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *JobEvent) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("new_status", thrift.I32, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(int32(p.NewStatus)); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *JobEvent) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("reason", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Reason); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *JobEvent) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("created_at", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.CreatedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *JobEvent) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JobEvent(%+v)", *p)
+
+}
+
+type QueryJobEventsRequest struct {
+	UUID        string `thrift:"uuid,1" json:"uuid" path:"uuid" vd:"len($) > 0"`
+	Creator     string `thrift:"creator,2" json:"creator" query:"creator" vd:"len($) < 32 && !regexp('.*\\.\\..*')"`
+	AccessToken string `thrift:"access_token,255,required" header:"Authorization,required" json:"access_token,required"`
+}
+
+func NewQueryJobEventsRequest() *QueryJobEventsRequest {
+	return &QueryJobEventsRequest{}
+}
+
+func (p *QueryJobEventsRequest) InitDefault() {
+}
+
+func (p *QueryJobEventsRequest) GetUUID() (v string) {
+	return p.UUID
+}
+
+func (p *QueryJobEventsRequest) GetCreator() (v string) {
+	return p.Creator
+}
+
+func (p *QueryJobEventsRequest) GetAccessToken() (v string) {
+	return p.AccessToken
+}
+
+var fieldIDToName_QueryJobEventsRequest = map[int16]string{
+	1:   "uuid",
+	2:   "creator",
+	255: "access_token",
+}
+
+func (p *QueryJobEventsRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetAccessToken bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetAccessToken = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetAccessToken {
+		fieldId = 255
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_QueryJobEventsRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_QueryJobEventsRequest[fieldId]))
+}
+
+func (p *QueryJobEventsRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UUID = _field
+	return nil
+}
+func (p *QueryJobEventsRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Creator = _field
+	return nil
+}
+func (p *QueryJobEventsRequest) ReadField255(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.AccessToken = _field
+	return nil
+}
+
+func (p *QueryJobEventsRequest) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("QueryJobEventsRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *QueryJobEventsRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("uuid", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.UUID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *QueryJobEventsRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("creator", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Creator); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *QueryJobEventsRequest) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("access_token", thrift.STRING, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.AccessToken); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *QueryJobEventsRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("QueryJobEventsRequest(%+v)", *p)
+
+}
+
+type QueryJobEventsResponse struct {
+	Code   int32       `thrift:"code,1" form:"code" json:"code" query:"code"`
+	Msg    string      `thrift:"msg,2" form:"msg" json:"msg" query:"msg"`
+	Events []*JobEvent `thrift:"events,3" form:"events" json:"events" query:"events"`
+}
+
+func NewQueryJobEventsResponse() *QueryJobEventsResponse {
+	return &QueryJobEventsResponse{}
+}
+
+func (p *QueryJobEventsResponse) InitDefault() {
+}
+
+func (p *QueryJobEventsResponse) GetCode() (v int32) {
+	return p.Code
+}
+
+func (p *QueryJobEventsResponse) GetMsg() (v string) {
+	return p.Msg
+}
+
+func (p *QueryJobEventsResponse) GetEvents() (v []*JobEvent) {
+	return p.Events
+}
+
+var fieldIDToName_QueryJobEventsResponse = map[int16]string{
+	1: "code",
+	2: "msg",
+	3: "events",
+}
+
+func (p *QueryJobEventsResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_QueryJobEventsResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *QueryJobEventsResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Code = _field
+	return nil
+}
+func (p *QueryJobEventsResponse) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Msg = _field
+	return nil
+}
+func (p *QueryJobEventsResponse) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*JobEvent, 0, size)
+	values := make([]JobEvent, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Events = _field
+	return nil
+}
+
+func (p *QueryJobEventsResponse) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("QueryJobEventsResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *QueryJobEventsResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Code); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *QueryJobEventsResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Msg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *QueryJobEventsResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("events", thrift.LIST, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Events)); err != nil {
+		return err
+	}
+	for _, v := range p.Events {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *QueryJobEventsResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("QueryJobEventsResponse(%+v)", *p)
+
+}
+
+type QueryJobBuildLogsRequest struct {
+	UUID        string `thrift:"uuid,1" json:"uuid" path:"uuid" vd:"len($) > 0"`
+	Creator     string `thrift:"creator,2" json:"creator" query:"creator" vd:"len($) < 32 && !regexp('.*\\.\\..*')"`
+	Follow      bool   `thrift:"follow,3" json:"follow" query:"follow"`
+	Tail        int64  `thrift:"tail,4" json:"tail" query:"tail" vd:"$ >= 0"`
+	AccessToken string `thrift:"access_token,255,required" header:"Authorization,required" json:"access_token,required"`
+}
+
+func NewQueryJobBuildLogsRequest() *QueryJobBuildLogsRequest {
+	return &QueryJobBuildLogsRequest{}
+}
+
+func (p *QueryJobBuildLogsRequest) InitDefault() {
+}
+
+func (p *QueryJobBuildLogsRequest) GetUUID() (v string) {
+	return p.UUID
+}
+
+func (p *QueryJobBuildLogsRequest) GetCreator() (v string) {
+	return p.Creator
+}
+
+func (p *QueryJobBuildLogsRequest) GetFollow() (v bool) {
+	return p.Follow
+}
+
+func (p *QueryJobBuildLogsRequest) GetTail() (v int64) {
+	return p.Tail
+}
+
+func (p *QueryJobBuildLogsRequest) GetAccessToken() (v string) {
+	return p.AccessToken
+}
+
+var fieldIDToName_QueryJobBuildLogsRequest = map[int16]string{
+	1:   "uuid",
+	2:   "creator",
+	3:   "follow",
+	4:   "tail",
+	255: "access_token",
+}
+
+func (p *QueryJobBuildLogsRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetAccessToken bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetAccessToken = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetAccessToken {
+		fieldId = 255
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_QueryJobBuildLogsRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_QueryJobBuildLogsRequest[fieldId]))
+}
+
+func (p *QueryJobBuildLogsRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UUID = _field
+	return nil
+}
+func (p *QueryJobBuildLogsRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Creator = _field
+	return nil
+}
+func (p *QueryJobBuildLogsRequest) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Follow = _field
+	return nil
+}
+func (p *QueryJobBuildLogsRequest) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Tail = _field
+	return nil
+}
+func (p *QueryJobBuildLogsRequest) ReadField255(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.AccessToken = _field
+	return nil
+}
+
+func (p *QueryJobBuildLogsRequest) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("QueryJobBuildLogsRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *QueryJobBuildLogsRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("uuid", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.UUID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *QueryJobBuildLogsRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("creator", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Creator); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *QueryJobBuildLogsRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("follow", thrift.BOOL, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Follow); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *QueryJobBuildLogsRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("tail", thrift.I64, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.Tail); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *QueryJobBuildLogsRequest) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("access_token", thrift.STRING, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.AccessToken); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *QueryJobBuildLogsRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("QueryJobBuildLogsRequest(%+v)", *p)
+
+}
+
+type QueryJobBuildLogsResponse struct {
+	Code int32  `thrift:"code,1" form:"code" json:"code" query:"code"`
+	Msg  string `thrift:"msg,2" form:"msg" json:"msg" query:"msg"`
+}
+
+func NewQueryJobBuildLogsResponse() *QueryJobBuildLogsResponse {
+	return &QueryJobBuildLogsResponse{}
+}
+
+func (p *QueryJobBuildLogsResponse) InitDefault() {
+}
+
+func (p *QueryJobBuildLogsResponse) GetCode() (v int32) {
+	return p.Code
+}
+
+func (p *QueryJobBuildLogsResponse) GetMsg() (v string) {
+	return p.Msg
+}
+
+var fieldIDToName_QueryJobBuildLogsResponse = map[int16]string{
+	1: "code",
+	2: "msg",
+}
+
+func (p *QueryJobBuildLogsResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_QueryJobBuildLogsResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *QueryJobBuildLogsResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Code = _field
+	return nil
+}
+func (p *QueryJobBuildLogsResponse) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Msg = _field
+	return nil
+}
+
+func (p *QueryJobBuildLogsResponse) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("QueryJobBuildLogsResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *QueryJobBuildLogsResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Code); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *QueryJobBuildLogsResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Msg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *QueryJobBuildLogsResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("QueryJobBuildLogsResponse(%+v)", *p)
+
+}
+
+type DownloadJobOutputRequest struct {
+	ID          int64  `thrift:"id,1" form:"id" json:"id" query:"id" vd:"$>0"`
+	Creator     string `thrift:"creator,2" form:"creator" json:"creator" vd:"len($) > 0 && len($) < 32 && !regexp('.*\\.\\..*')"`
+	AccessToken string `thrift:"access_token,255,required" header:"Authorization,required" json:"access_token,required"`
+}
+
+func NewDownloadJobOutputRequest() *DownloadJobOutputRequest {
+	return &DownloadJobOutputRequest{}
+}
+
+func (p *DownloadJobOutputRequest) InitDefault() {
+}
+
+func (p *DownloadJobOutputRequest) GetID() (v int64) {
+	return p.ID
+}
+
+func (p *DownloadJobOutputRequest) GetCreator() (v string) {
+	return p.Creator
+}
+
+func (p *DownloadJobOutputRequest) GetAccessToken() (v string) {
+	return p.AccessToken
+}
+
+var fieldIDToName_DownloadJobOutputRequest = map[int16]string{
+	1:   "id",
+	2:   "creator",
+	255: "access_token",
+}
+
+func (p *DownloadJobOutputRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetAccessToken bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetAccessToken = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetAccessToken {
+		fieldId = 255
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DownloadJobOutputRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_DownloadJobOutputRequest[fieldId]))
+}
+
+func (p *DownloadJobOutputRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ID = _field
+	return nil
+}
+func (p *DownloadJobOutputRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Creator = _field
+	return nil
+}
+func (p *DownloadJobOutputRequest) ReadField255(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.AccessToken = _field
+	return nil
+}
+
+func (p *DownloadJobOutputRequest) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DownloadJobOutputRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *DownloadJobOutputRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *DownloadJobOutputRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("creator", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Creator); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *DownloadJobOutputRequest) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("access_token", thrift.STRING, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.AccessToken); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *DownloadJobOutputRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DownloadJobOutputRequest(%+v)", *p)
+
+}
+
+type DownloadJobOutputResponse struct {
+	Code      int32  `thrift:"code,1" form:"code" json:"code" query:"code"`
+	Msg       string `thrift:"msg,2" form:"msg" json:"msg" query:"msg"`
+	SignedURL string `thrift:"signed_url,3" form:"signed_url" json:"signed_url" query:"signed_url"`
+	Filename  string `thrift:"filename,4" form:"filename" json:"filename" query:"filename"`
+}
+
+func NewDownloadJobOutputResponse() *DownloadJobOutputResponse {
+	return &DownloadJobOutputResponse{}
+}
+
+func (p *DownloadJobOutputResponse) InitDefault() {
+}
+
+func (p *DownloadJobOutputResponse) GetCode() (v int32) {
+	return p.Code
+}
+
+func (p *DownloadJobOutputResponse) GetMsg() (v string) {
+	return p.Msg
+}
+
+func (p *DownloadJobOutputResponse) GetSignedURL() (v string) {
+	return p.SignedURL
+}
+
+func (p *DownloadJobOutputResponse) GetFilename() (v string) {
+	return p.Filename
+}
+
+var fieldIDToName_DownloadJobOutputResponse = map[int16]string{
+	1: "code",
+	2: "msg",
+	3: "signed_url",
+	4: "filename",
+}
+
+func (p *DownloadJobOutputResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DownloadJobOutputResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *DownloadJobOutputResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Code = _field
+	return nil
+}
+func (p *DownloadJobOutputResponse) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Msg = _field
+	return nil
+}
+func (p *DownloadJobOutputResponse) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.SignedURL = _field
+	return nil
+}
+func (p *DownloadJobOutputResponse) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Filename = _field
+	return nil
+}
+
+func (p *DownloadJobOutputResponse) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DownloadJobOutputResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *DownloadJobOutputResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Code); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *DownloadJobOutputResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Msg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *DownloadJobOutputResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("signed_url", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.SignedURL); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *DownloadJobOutputResponse) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("filename", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Filename); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *DownloadJobOutputResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DownloadJobOutputResponse(%+v)", *p)
+
+}
+
+type QueryJobAttestationRequest struct {
+	ID          int64  `thrift:"id,1" form:"id" json:"id" query:"id" vd:"$>0"`
+	Creator     string `thrift:"creator,2" form:"creator" json:"creator" vd:"len($) > 0 && len($) < 32 && !regexp('.*\\.\\..*')"`
+	AccessToken string `thrift:"access_token,255,required" header:"Authorization,required" json:"access_token,required"`
+}
+
+func NewQueryJobAttestationRequest() *QueryJobAttestationRequest {
+	return &QueryJobAttestationRequest{}
+}
+
+func (p *QueryJobAttestationRequest) InitDefault() {
+}
+
+func (p *QueryJobAttestationRequest) GetID() (v int64) {
+	return p.ID
+}
+
+func (p *QueryJobAttestationRequest) GetCreator() (v string) {
+	return p.Creator
+}
+
+func (p *QueryJobAttestationRequest) GetAccessToken() (v string) {
+	return p.AccessToken
+}
+
+var fieldIDToName_QueryJobAttestationRequest = map[int16]string{
+	1:   "id",
+	2:   "creator",
+	255: "access_token",
+}
+
+func (p *QueryJobAttestationRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetAccessToken bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetAccessToken = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetAccessToken {
+		fieldId = 255
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_QueryJobAttestationRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_QueryJobAttestationRequest[fieldId]))
+}
+
+func (p *QueryJobAttestationRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ID = _field
+	return nil
+}
+func (p *QueryJobAttestationRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Creator = _field
+	return nil
+}
+func (p *QueryJobAttestationRequest) ReadField255(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.AccessToken = _field
+	return nil
+}
+
+func (p *QueryJobAttestationRequest) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("QueryJobAttestationRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *QueryJobAttestationRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *QueryJobAttestationRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("creator", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Creator); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *QueryJobAttestationRequest) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("access_token", thrift.STRING, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.AccessToken); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *QueryJobAttestationRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("QueryJobAttestationRequest(%+v)", *p)
+
+}
+
+type QueryJobAttestationResponse struct {
+	Code              int32  `thrift:"code,1" form:"code" json:"code" query:"code"`
+	Msg               string `thrift:"msg,2" form:"msg" json:"msg" query:"msg"`
+	SignedURL         string `thrift:"signed_url,3" form:"signed_url" json:"signed_url" query:"signed_url"`
+	ManifestSignedURL string `thrift:"manifest_signed_url,4" form:"manifest_signed_url" json:"manifest_signed_url" query:"manifest_signed_url"`
+	TokenType         string `thrift:"token_type,5" form:"token_type" json:"token_type" query:"token_type"`
+}
+
+func NewQueryJobAttestationResponse() *QueryJobAttestationResponse {
+	return &QueryJobAttestationResponse{}
+}
+
+func (p *QueryJobAttestationResponse) InitDefault() {
+}
+
+func (p *QueryJobAttestationResponse) GetCode() (v int32) {
+	return p.Code
+}
+
+func (p *QueryJobAttestationResponse) GetMsg() (v string) {
+	return p.Msg
+}
+
+func (p *QueryJobAttestationResponse) GetSignedURL() (v string) {
+	return p.SignedURL
+}
+
+func (p *QueryJobAttestationResponse) GetManifestSignedURL() (v string) {
+	return p.ManifestSignedURL
+}
+
+func (p *QueryJobAttestationResponse) GetTokenType() (v string) {
+	return p.TokenType
+}
+
+var fieldIDToName_QueryJobAttestationResponse = map[int16]string{
+	1: "code",
+	2: "msg",
+	3: "signed_url",
+	4: "manifest_signed_url",
+	5: "token_type",
+}
+
+func (p *QueryJobAttestationResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_QueryJobAttestationResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *QueryJobAttestationResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Code = _field
+	return nil
+}
+func (p *QueryJobAttestationResponse) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Msg = _field
+	return nil
+}
+func (p *QueryJobAttestationResponse) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.SignedURL = _field
+	return nil
+}
+func (p *QueryJobAttestationResponse) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ManifestSignedURL = _field
+	return nil
+}
+func (p *QueryJobAttestationResponse) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.TokenType = _field
+	return nil
+}
+
+func (p *QueryJobAttestationResponse) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("QueryJobAttestationResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *QueryJobAttestationResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Code); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *QueryJobAttestationResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Msg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *QueryJobAttestationResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("signed_url", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.SignedURL); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *QueryJobAttestationResponse) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("manifest_signed_url", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.ManifestSignedURL); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *QueryJobAttestationResponse) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("token_type", thrift.STRING, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.TokenType); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *QueryJobAttestationResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("QueryJobAttestationResponse(%+v)", *p)
+
+}
+
+type AttestationCheck struct {
+	Name   string `thrift:"name,1" form:"name" json:"name" query:"name"`
+	Passed bool   `thrift:"passed,2" form:"passed" json:"passed" query:"passed"`
+	Detail string `thrift:"detail,3" form:"detail" json:"detail" query:"detail"`
+}
+
+func NewAttestationCheck() *AttestationCheck {
+	return &AttestationCheck{}
+}
+
+func (p *AttestationCheck) InitDefault() {
+}
+
+func (p *AttestationCheck) GetName() (v string) {
+	return p.Name
+}
+
+func (p *AttestationCheck) GetPassed() (v bool) {
+	return p.Passed
+}
+
+func (p *AttestationCheck) GetDetail() (v string) {
+	return p.Detail
+}
+
+var fieldIDToName_AttestationCheck = map[int16]string{
+	1: "name",
+	2: "passed",
+	3: "detail",
+}
+
+func (p *AttestationCheck) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AttestationCheck[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AttestationCheck) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Name = _field
+	return nil
+}
+func (p *AttestationCheck) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Passed = _field
+	return nil
+}
+func (p *AttestationCheck) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Detail = _field
+	return nil
+}
+
+func (p *AttestationCheck) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AttestationCheck"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AttestationCheck) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("name", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Name); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AttestationCheck) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("passed", thrift.BOOL, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Passed); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *AttestationCheck) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("detail", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Detail); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *AttestationCheck) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AttestationCheck(%+v)", *p)
+
+}
+
+type VerifyJobAttestationRequest struct {
+	UUID        string `thrift:"uuid,1" json:"uuid" path:"uuid" vd:"len($) > 0"`
+	Creator     string `thrift:"creator,2" json:"creator" query:"creator" vd:"len($) < 32 && !regexp('.*\\.\\..*')"`
+	AccessToken string `thrift:"access_token,255,required" header:"Authorization,required" json:"access_token,required"`
+}
+
+func NewVerifyJobAttestationRequest() *VerifyJobAttestationRequest {
+	return &VerifyJobAttestationRequest{}
+}
+
+func (p *VerifyJobAttestationRequest) InitDefault() {
+}
+
+func (p *VerifyJobAttestationRequest) GetUUID() (v string) {
+	return p.UUID
+}
+
+func (p *VerifyJobAttestationRequest) GetCreator() (v string) {
+	return p.Creator
+}
+
+func (p *VerifyJobAttestationRequest) GetAccessToken() (v string) {
+	return p.AccessToken
+}
+
+var fieldIDToName_VerifyJobAttestationRequest = map[int16]string{
+	1:   "uuid",
+	2:   "creator",
+	255: "access_token",
+}
+
+func (p *VerifyJobAttestationRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetAccessToken bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetAccessToken = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetAccessToken {
+		fieldId = 255
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VerifyJobAttestationRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_VerifyJobAttestationRequest[fieldId]))
+}
+
+func (p *VerifyJobAttestationRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UUID = _field
+	return nil
+}
+func (p *VerifyJobAttestationRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Creator = _field
+	return nil
+}
+func (p *VerifyJobAttestationRequest) ReadField255(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.AccessToken = _field
+	return nil
+}
+
+func (p *VerifyJobAttestationRequest) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("VerifyJobAttestationRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *VerifyJobAttestationRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("uuid", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.UUID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *VerifyJobAttestationRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("creator", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Creator); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *VerifyJobAttestationRequest) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("access_token", thrift.STRING, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.AccessToken); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *VerifyJobAttestationRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VerifyJobAttestationRequest(%+v)", *p)
+
+}
+
+type VerifyJobAttestationResponse struct {
+	Code     int32               `thrift:"code,1" form:"code" json:"code" query:"code"`
+	Msg      string              `thrift:"msg,2" form:"msg" json:"msg" query:"msg"`
+	Verified bool                `thrift:"verified,3" form:"verified" json:"verified" query:"verified"`
+	Checks   []*AttestationCheck `thrift:"checks,4" form:"checks" json:"checks" query:"checks"`
+}
+
+func NewVerifyJobAttestationResponse() *VerifyJobAttestationResponse {
+	return &VerifyJobAttestationResponse{}
+}
+
+func (p *VerifyJobAttestationResponse) InitDefault() {
+}
+
+func (p *VerifyJobAttestationResponse) GetCode() (v int32) {
+	return p.Code
+}
+
+func (p *VerifyJobAttestationResponse) GetMsg() (v string) {
+	return p.Msg
+}
+
+func (p *VerifyJobAttestationResponse) GetVerified() (v bool) {
+	return p.Verified
+}
+
+func (p *VerifyJobAttestationResponse) GetChecks() (v []*AttestationCheck) {
+	return p.Checks
+}
+
+var fieldIDToName_VerifyJobAttestationResponse = map[int16]string{
+	1: "code",
+	2: "msg",
+	3: "verified",
+	4: "checks",
+}
+
+func (p *VerifyJobAttestationResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VerifyJobAttestationResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *VerifyJobAttestationResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Code = _field
+	return nil
+}
+func (p *VerifyJobAttestationResponse) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Msg = _field
+	return nil
+}
+func (p *VerifyJobAttestationResponse) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Verified = _field
+	return nil
+}
+func (p *VerifyJobAttestationResponse) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*AttestationCheck, 0, size)
+	values := make([]AttestationCheck, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Checks = _field
+	return nil
+}
+
+func (p *VerifyJobAttestationResponse) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("VerifyJobAttestationResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *VerifyJobAttestationResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Code); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *VerifyJobAttestationResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Msg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *VerifyJobAttestationResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("verified", thrift.BOOL, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Verified); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *VerifyJobAttestationResponse) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("checks", thrift.LIST, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Checks)); err != nil {
+		return err
+	}
+	for _, v := range p.Checks {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *VerifyJobAttestationResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VerifyJobAttestationResponse(%+v)", *p)
+
+}
+
+type UsageRecord struct {
+	Creator     string  `thrift:"creator,1" form:"creator" json:"creator" query:"creator"`
+	UUID        string  `thrift:"uuid,2" form:"uuid" json:"uuid" query:"uuid"`
+	MachineType string  `thrift:"machine_type,3" form:"machine_type" json:"machine_type" query:"machine_type"`
+	Jobs        int64   `thrift:"jobs,4" form:"jobs" json:"jobs" query:"jobs"`
+	VMSeconds   int64   `thrift:"vm_seconds,5" form:"vm_seconds" json:"vm_seconds" query:"vm_seconds"`
+	Cost        float64 `thrift:"cost,6" form:"cost" json:"cost" query:"cost"`
+}
+
+func NewUsageRecord() *UsageRecord {
+	return &UsageRecord{}
+}
+
+func (p *UsageRecord) InitDefault() {
+}
+
+func (p *UsageRecord) GetCreator() (v string) {
+	return p.Creator
+}
+
+func (p *UsageRecord) GetUUID() (v string) {
+	return p.UUID
+}
+
+func (p *UsageRecord) GetMachineType() (v string) {
+	return p.MachineType
+}
+
+func (p *UsageRecord) GetJobs() (v int64) {
+	return p.Jobs
+}
+
+func (p *UsageRecord) GetVMSeconds() (v int64) {
+	return p.VMSeconds
+}
+
+func (p *UsageRecord) GetCost() (v float64) {
+	return p.Cost
+}
+
+var fieldIDToName_UsageRecord = map[int16]string{
+	1: "creator",
+	2: "uuid",
+	3: "machine_type",
+	4: "jobs",
+	5: "vm_seconds",
+	6: "cost",
+}
+
+func (p *UsageRecord) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UsageRecord[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UsageRecord) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Creator = _field
+	return nil
+}
+func (p *UsageRecord) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UUID = _field
+	return nil
+}
+func (p *UsageRecord) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.MachineType = _field
+	return nil
+}
+func (p *UsageRecord) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Jobs = _field
+	return nil
+}
+func (p *UsageRecord) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.VMSeconds = _field
+	return nil
+}
+func (p *UsageRecord) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Cost = _field
+	return nil
+}
+
+func (p *UsageRecord) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UsageRecord"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UsageRecord) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("creator", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Creator); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UsageRecord) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("uuid", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.UUID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *UsageRecord) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("machine_type", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.MachineType); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *UsageRecord) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("jobs", thrift.I64, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.Jobs); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *UsageRecord) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("vm_seconds", thrift.I64, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.VMSeconds); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *UsageRecord) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("cost", thrift.DOUBLE, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteDouble(p.Cost); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *UsageRecord) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UsageRecord(%+v)", *p)
+
+}
+
+type QueryUsageRequest struct {
+	Start       string `thrift:"start,1" json:"start" query:"start"`
+	End         string `thrift:"end,2" json:"end" query:"end"`
+	Creator     string `thrift:"creator,3" json:"creator" query:"creator" vd:"len($) < 32 && !regexp('.*\\.\\..*')"`
+	GroupBy     string `thrift:"group_by,4" json:"group_by" query:"group_by" vd:"$ == '' || $ == 'creator' || $ == 'job'"`
+	Format      string `thrift:"format,5" json:"format" query:"format" vd:"$ == '' || $ == 'json' || $ == 'csv'"`
+	AccessToken string `thrift:"access_token,255,required" header:"Authorization,required" json:"access_token,required"`
+}
+
+func NewQueryUsageRequest() *QueryUsageRequest {
+	return &QueryUsageRequest{}
+}
+
+func (p *QueryUsageRequest) InitDefault() {
+}
+
+func (p *QueryUsageRequest) GetStart() (v string) {
+	return p.Start
+}
+
+func (p *QueryUsageRequest) GetEnd() (v string) {
+	return p.End
+}
+
+func (p *QueryUsageRequest) GetCreator() (v string) {
+	return p.Creator
+}
+
+func (p *QueryUsageRequest) GetGroupBy() (v string) {
+	return p.GroupBy
+}
+
+func (p *QueryUsageRequest) GetFormat() (v string) {
+	return p.Format
+}
+
+func (p *QueryUsageRequest) GetAccessToken() (v string) {
+	return p.AccessToken
+}
+
+var fieldIDToName_QueryUsageRequest = map[int16]string{
+	1:   "start",
+	2:   "end",
+	3:   "creator",
+	4:   "group_by",
+	5:   "format",
+	255: "access_token",
+}
+
+func (p *QueryUsageRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetAccessToken bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetAccessToken = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetAccessToken {
+		fieldId = 255
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_QueryUsageRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_QueryUsageRequest[fieldId]))
+}
+
+func (p *QueryUsageRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Start = _field
+	return nil
+}
+func (p *QueryUsageRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.End = _field
+	return nil
+}
+func (p *QueryUsageRequest) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Creator = _field
+	return nil
+}
+func (p *QueryUsageRequest) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.GroupBy = _field
+	return nil
+}
+func (p *QueryUsageRequest) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Format = _field
+	return nil
+}
+func (p *QueryUsageRequest) ReadField255(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.AccessToken = _field
+	return nil
+}
+
+func (p *QueryUsageRequest) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("QueryUsageRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *QueryUsageRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("start", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Start); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *QueryUsageRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("end", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.End); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *QueryUsageRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("creator", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Creator); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *QueryUsageRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("group_by", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.GroupBy); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *QueryUsageRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("format", thrift.STRING, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Format); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *QueryUsageRequest) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("access_token", thrift.STRING, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.AccessToken); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *QueryUsageRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("QueryUsageRequest(%+v)", *p)
+
+}
+
+type QueryUsageResponse struct {
+	Code     int32          `thrift:"code,1" form:"code" json:"code" query:"code"`
+	Msg      string         `thrift:"msg,2" form:"msg" json:"msg" query:"msg"`
+	Usage    []*UsageRecord `thrift:"usage,3" form:"usage" json:"usage" query:"usage"`
+	Currency string         `thrift:"currency,4" form:"currency" json:"currency" query:"currency"`
+}
+
+func NewQueryUsageResponse() *QueryUsageResponse {
+	return &QueryUsageResponse{}
+}
+
+func (p *QueryUsageResponse) InitDefault() {
+}
+
+func (p *QueryUsageResponse) GetCode() (v int32) {
+	return p.Code
+}
+
+func (p *QueryUsageResponse) GetMsg() (v string) {
+	return p.Msg
+}
+
+func (p *QueryUsageResponse) GetUsage() (v []*UsageRecord) {
+	return p.Usage
+}
+
+func (p *QueryUsageResponse) GetCurrency() (v string) {
+	return p.Currency
+}
+
+var fieldIDToName_QueryUsageResponse = map[int16]string{
+	1: "code",
+	2: "msg",
+	3: "usage",
+	4: "currency",
+}
+
+func (p *QueryUsageResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_QueryUsageResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *QueryUsageResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Code = _field
+	return nil
+}
+func (p *QueryUsageResponse) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Msg = _field
+	return nil
+}
+func (p *QueryUsageResponse) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*UsageRecord, 0, size)
+	values := make([]UsageRecord, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Usage = _field
+	return nil
+}
+func (p *QueryUsageResponse) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Currency = _field
+	return nil
+}
+
+func (p *QueryUsageResponse) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("QueryUsageResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *QueryUsageResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("code", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Code); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *QueryUsageResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("msg", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Msg); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *QueryUsageResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("usage", thrift.LIST, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Usage)); err != nil {
+		return err
+	}
+	for _, v := range p.Usage {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *QueryUsageResponse) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("currency", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Currency); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *QueryUsageResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("QueryUsageResponse(%+v)", *p)
+
+}
+
+type JobHandler interface {
+	SubmitJob(ctx context.Context, req *SubmitJobRequest) (r *SubmitJobResponse, err error)
+
+	QueryJob(ctx context.Context, req *QueryJobRequest) (r *QueryJobResponse, err error)
+
+	QueryJobDetail(ctx context.Context, req *QueryJobDetailRequest) (r *QueryJobDetailResponse, err error)
+
+	QueryJobEvents(ctx context.Context, req *QueryJobEventsRequest) (r *QueryJobEventsResponse, err error)
+
+	QueryJobBuildLogs(ctx context.Context, req *QueryJobBuildLogsRequest) (r *QueryJobBuildLogsResponse, err error)
+
+	DeleteJob(ctx context.Context, req *DeleteJobRequest) (r *DeleteJobResponse, err error)
+
+	CancelJob(ctx context.Context, req *CancelJobRequest) (r *CancelJobResponse, err error)
+
+	DownloadJobOutput(ctx context.Context, req *DownloadJobOutputRequest) (r *DownloadJobOutputResponse, err error)
+
+	QueryJobAttestationReport(ctx context.Context, req *QueryJobAttestationRequest) (r *QueryJobAttestationResponse, err error)
+
+	VerifyJobAttestation(ctx context.Context, req *VerifyJobAttestationRequest) (r *VerifyJobAttestationResponse, err error)
+
+	QueryUsage(ctx context.Context, req *QueryUsageRequest) (r *QueryUsageResponse, err error)
+}
+
+type JobHandlerClient struct {
+	c thrift.TClient
+}
+
+func NewJobHandlerClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *JobHandlerClient {
+	return &JobHandlerClient{
+		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	}
+}
+
+func NewJobHandlerClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *JobHandlerClient {
+	return &JobHandlerClient{
+		c: thrift.NewTStandardClient(iprot, oprot),
+	}
+}
+
+func NewJobHandlerClient(c thrift.TClient) *JobHandlerClient {
+	return &JobHandlerClient{
+		c: c,
+	}
+}
+
+func (p *JobHandlerClient) Client_() thrift.TClient {
+	return p.c
+}
+
+func (p *JobHandlerClient) SubmitJob(ctx context.Context, req *SubmitJobRequest) (r *SubmitJobResponse, err error) {
+	var _args JobHandlerSubmitJobArgs
+	_args.Req = req
+	var _result JobHandlerSubmitJobResult
+	if err = p.Client_().Call(ctx, "SubmitJob", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *JobHandlerClient) QueryJob(ctx context.Context, req *QueryJobRequest) (r *QueryJobResponse, err error) {
+	var _args JobHandlerQueryJobArgs
+	_args.Req = req
+	var _result JobHandlerQueryJobResult
+	if err = p.Client_().Call(ctx, "QueryJob", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *JobHandlerClient) QueryJobDetail(ctx context.Context, req *QueryJobDetailRequest) (r *QueryJobDetailResponse, err error) {
+	var _args JobHandlerQueryJobDetailArgs
+	_args.Req = req
+	var _result JobHandlerQueryJobDetailResult
+	if err = p.Client_().Call(ctx, "QueryJobDetail", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *JobHandlerClient) QueryJobEvents(ctx context.Context, req *QueryJobEventsRequest) (r *QueryJobEventsResponse, err error) {
+	var _args JobHandlerQueryJobEventsArgs
+	_args.Req = req
+	var _result JobHandlerQueryJobEventsResult
+	if err = p.Client_().Call(ctx, "QueryJobEvents", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *JobHandlerClient) QueryJobBuildLogs(ctx context.Context, req *QueryJobBuildLogsRequest) (r *QueryJobBuildLogsResponse, err error) {
+	var _args JobHandlerQueryJobBuildLogsArgs
+	_args.Req = req
+	var _result JobHandlerQueryJobBuildLogsResult
+	if err = p.Client_().Call(ctx, "QueryJobBuildLogs", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *JobHandlerClient) DeleteJob(ctx context.Context, req *DeleteJobRequest) (r *DeleteJobResponse, err error) {
+	var _args JobHandlerDeleteJobArgs
+	_args.Req = req
+	var _result JobHandlerDeleteJobResult
+	if err = p.Client_().Call(ctx, "DeleteJob", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *JobHandlerClient) CancelJob(ctx context.Context, req *CancelJobRequest) (r *CancelJobResponse, err error) {
+	var _args JobHandlerCancelJobArgs
+	_args.Req = req
+	var _result JobHandlerCancelJobResult
+	if err = p.Client_().Call(ctx, "CancelJob", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *JobHandlerClient) DownloadJobOutput(ctx context.Context, req *DownloadJobOutputRequest) (r *DownloadJobOutputResponse, err error) {
+	var _args JobHandlerDownloadJobOutputArgs
+	_args.Req = req
+	var _result JobHandlerDownloadJobOutputResult
+	if err = p.Client_().Call(ctx, "DownloadJobOutput", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *JobHandlerClient) QueryJobAttestationReport(ctx context.Context, req *QueryJobAttestationRequest) (r *QueryJobAttestationResponse, err error) {
+	var _args JobHandlerQueryJobAttestationReportArgs
+	_args.Req = req
+	var _result JobHandlerQueryJobAttestationReportResult
+	if err = p.Client_().Call(ctx, "QueryJobAttestationReport", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *JobHandlerClient) VerifyJobAttestation(ctx context.Context, req *VerifyJobAttestationRequest) (r *VerifyJobAttestationResponse, err error) {
+	var _args JobHandlerVerifyJobAttestationArgs
+	_args.Req = req
+	var _result JobHandlerVerifyJobAttestationResult
+	if err = p.Client_().Call(ctx, "VerifyJobAttestation", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *JobHandlerClient) QueryUsage(ctx context.Context, req *QueryUsageRequest) (r *QueryUsageResponse, err error) {
+	var _args JobHandlerQueryUsageArgs
+	_args.Req = req
+	var _result JobHandlerQueryUsageResult
+	if err = p.Client_().Call(ctx, "QueryUsage", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+type JobHandlerProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      JobHandler
+}
+
+func (p *JobHandlerProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
+}
+
+func (p *JobHandlerProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
+}
+
+func (p *JobHandlerProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
+
+func NewJobHandlerProcessor(handler JobHandler) *JobHandlerProcessor {
+	self := &JobHandlerProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("SubmitJob", &jobHandlerProcessorSubmitJob{handler: handler})
+	self.AddToProcessorMap("QueryJob", &jobHandlerProcessorQueryJob{handler: handler})
+	self.AddToProcessorMap("QueryJobDetail", &jobHandlerProcessorQueryJobDetail{handler: handler})
+	self.AddToProcessorMap("QueryJobEvents", &jobHandlerProcessorQueryJobEvents{handler: handler})
+	self.AddToProcessorMap("QueryJobBuildLogs", &jobHandlerProcessorQueryJobBuildLogs{handler: handler})
+	self.AddToProcessorMap("DeleteJob", &jobHandlerProcessorDeleteJob{handler: handler})
+	self.AddToProcessorMap("CancelJob", &jobHandlerProcessorCancelJob{handler: handler})
+	self.AddToProcessorMap("DownloadJobOutput", &jobHandlerProcessorDownloadJobOutput{handler: handler})
 	self.AddToProcessorMap("QueryJobAttestationReport", &jobHandlerProcessorQueryJobAttestationReport{handler: handler})
+	self.AddToProcessorMap("VerifyJobAttestation", &jobHandlerProcessorVerifyJobAttestation{handler: handler})
+	self.AddToProcessorMap("QueryUsage", &jobHandlerProcessorQueryUsage{handler: handler})
 	return self
 }
-func (p *JobHandlerProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	name, _, seqId, err := iprot.ReadMessageBegin()
-	if err != nil {
-		return false, err
+func (p *JobHandlerProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return false, err
+	}
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush(ctx)
+	return false, x
+}
+
+type jobHandlerProcessorSubmitJob struct {
+	handler JobHandler
+}
+
+func (p *jobHandlerProcessorSubmitJob) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := JobHandlerSubmitJobArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("SubmitJob", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := JobHandlerSubmitJobResult{}
+	var retval *SubmitJobResponse
+	if retval, err2 = p.handler.SubmitJob(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SubmitJob: "+err2.Error())
+		oprot.WriteMessageBegin("SubmitJob", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("SubmitJob", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type jobHandlerProcessorQueryJob struct {
+	handler JobHandler
+}
+
+func (p *jobHandlerProcessorQueryJob) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := JobHandlerQueryJobArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("QueryJob", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := JobHandlerQueryJobResult{}
+	var retval *QueryJobResponse
+	if retval, err2 = p.handler.QueryJob(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing QueryJob: "+err2.Error())
+		oprot.WriteMessageBegin("QueryJob", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("QueryJob", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type jobHandlerProcessorQueryJobDetail struct {
+	handler JobHandler
+}
+
+func (p *jobHandlerProcessorQueryJobDetail) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := JobHandlerQueryJobDetailArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("QueryJobDetail", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := JobHandlerQueryJobDetailResult{}
+	var retval *QueryJobDetailResponse
+	if retval, err2 = p.handler.QueryJobDetail(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing QueryJobDetail: "+err2.Error())
+		oprot.WriteMessageBegin("QueryJobDetail", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("QueryJobDetail", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type jobHandlerProcessorQueryJobEvents struct {
+	handler JobHandler
+}
+
+func (p *jobHandlerProcessorQueryJobEvents) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := JobHandlerQueryJobEventsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("QueryJobEvents", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := JobHandlerQueryJobEventsResult{}
+	var retval *QueryJobEventsResponse
+	if retval, err2 = p.handler.QueryJobEvents(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing QueryJobEvents: "+err2.Error())
+		oprot.WriteMessageBegin("QueryJobEvents", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("QueryJobEvents", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type jobHandlerProcessorQueryJobBuildLogs struct {
+	handler JobHandler
+}
+
+func (p *jobHandlerProcessorQueryJobBuildLogs) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := JobHandlerQueryJobBuildLogsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("QueryJobBuildLogs", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := JobHandlerQueryJobBuildLogsResult{}
+	var retval *QueryJobBuildLogsResponse
+	if retval, err2 = p.handler.QueryJobBuildLogs(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing QueryJobBuildLogs: "+err2.Error())
+		oprot.WriteMessageBegin("QueryJobBuildLogs", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("QueryJobBuildLogs", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type jobHandlerProcessorDeleteJob struct {
+	handler JobHandler
+}
+
+func (p *jobHandlerProcessorDeleteJob) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := JobHandlerDeleteJobArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("DeleteJob", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := JobHandlerDeleteJobResult{}
+	var retval *DeleteJobResponse
+	if retval, err2 = p.handler.DeleteJob(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DeleteJob: "+err2.Error())
+		oprot.WriteMessageBegin("DeleteJob", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("DeleteJob", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type jobHandlerProcessorCancelJob struct {
+	handler JobHandler
+}
+
+func (p *jobHandlerProcessorCancelJob) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := JobHandlerCancelJobArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("CancelJob", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := JobHandlerCancelJobResult{}
+	var retval *CancelJobResponse
+	if retval, err2 = p.handler.CancelJob(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CancelJob: "+err2.Error())
+		oprot.WriteMessageBegin("CancelJob", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("CancelJob", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type jobHandlerProcessorDownloadJobOutput struct {
+	handler JobHandler
+}
+
+func (p *jobHandlerProcessorDownloadJobOutput) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := JobHandlerDownloadJobOutputArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("DownloadJobOutput", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := JobHandlerDownloadJobOutputResult{}
+	var retval *DownloadJobOutputResponse
+	if retval, err2 = p.handler.DownloadJobOutput(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DownloadJobOutput: "+err2.Error())
+		oprot.WriteMessageBegin("DownloadJobOutput", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("DownloadJobOutput", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type jobHandlerProcessorQueryJobAttestationReport struct {
+	handler JobHandler
+}
+
+func (p *jobHandlerProcessorQueryJobAttestationReport) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := JobHandlerQueryJobAttestationReportArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("QueryJobAttestationReport", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := JobHandlerQueryJobAttestationReportResult{}
+	var retval *QueryJobAttestationResponse
+	if retval, err2 = p.handler.QueryJobAttestationReport(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing QueryJobAttestationReport: "+err2.Error())
+		oprot.WriteMessageBegin("QueryJobAttestationReport", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("QueryJobAttestationReport", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type jobHandlerProcessorVerifyJobAttestation struct {
+	handler JobHandler
+}
+
+func (p *jobHandlerProcessorVerifyJobAttestation) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := JobHandlerVerifyJobAttestationArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("VerifyJobAttestation", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := JobHandlerVerifyJobAttestationResult{}
+	var retval *VerifyJobAttestationResponse
+	if retval, err2 = p.handler.VerifyJobAttestation(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing VerifyJobAttestation: "+err2.Error())
+		oprot.WriteMessageBegin("VerifyJobAttestation", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("VerifyJobAttestation", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type jobHandlerProcessorQueryUsage struct {
+	handler JobHandler
+}
+
+func (p *jobHandlerProcessorQueryUsage) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := JobHandlerQueryUsageArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("QueryUsage", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := JobHandlerQueryUsageResult{}
+	var retval *QueryUsageResponse
+	if retval, err2 = p.handler.QueryUsage(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing QueryUsage: "+err2.Error())
+		oprot.WriteMessageBegin("QueryUsage", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("QueryUsage", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type JobHandlerSubmitJobArgs struct {
+	Req *SubmitJobRequest `thrift:"req,1"`
+}
+
+func NewJobHandlerSubmitJobArgs() *JobHandlerSubmitJobArgs {
+	return &JobHandlerSubmitJobArgs{}
+}
+
+func (p *JobHandlerSubmitJobArgs) InitDefault() {
+}
+
+var JobHandlerSubmitJobArgs_Req_DEFAULT *SubmitJobRequest
+
+func (p *JobHandlerSubmitJobArgs) GetReq() (v *SubmitJobRequest) {
+	if !p.IsSetReq() {
+		return JobHandlerSubmitJobArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_JobHandlerSubmitJobArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *JobHandlerSubmitJobArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *JobHandlerSubmitJobArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerSubmitJobArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *JobHandlerSubmitJobArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewSubmitJobRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *JobHandlerSubmitJobArgs) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SubmitJob_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *JobHandlerSubmitJobArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *JobHandlerSubmitJobArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JobHandlerSubmitJobArgs(%+v)", *p)
+
+}
+
+type JobHandlerSubmitJobResult struct {
+	Success *SubmitJobResponse `thrift:"success,0,optional"`
+}
+
+func NewJobHandlerSubmitJobResult() *JobHandlerSubmitJobResult {
+	return &JobHandlerSubmitJobResult{}
+}
+
+func (p *JobHandlerSubmitJobResult) InitDefault() {
+}
+
+var JobHandlerSubmitJobResult_Success_DEFAULT *SubmitJobResponse
+
+func (p *JobHandlerSubmitJobResult) GetSuccess() (v *SubmitJobResponse) {
+	if !p.IsSetSuccess() {
+		return JobHandlerSubmitJobResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_JobHandlerSubmitJobResult = map[int16]string{
+	0: "success",
+}
+
+func (p *JobHandlerSubmitJobResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *JobHandlerSubmitJobResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerSubmitJobResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *JobHandlerSubmitJobResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewSubmitJobResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *JobHandlerSubmitJobResult) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SubmitJob_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *JobHandlerSubmitJobResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *JobHandlerSubmitJobResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JobHandlerSubmitJobResult(%+v)", *p)
+
+}
+
+type JobHandlerQueryJobArgs struct {
+	Req *QueryJobRequest `thrift:"req,1"`
+}
+
+func NewJobHandlerQueryJobArgs() *JobHandlerQueryJobArgs {
+	return &JobHandlerQueryJobArgs{}
+}
+
+func (p *JobHandlerQueryJobArgs) InitDefault() {
+}
+
+var JobHandlerQueryJobArgs_Req_DEFAULT *QueryJobRequest
+
+func (p *JobHandlerQueryJobArgs) GetReq() (v *QueryJobRequest) {
+	if !p.IsSetReq() {
+		return JobHandlerQueryJobArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_JobHandlerQueryJobArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *JobHandlerQueryJobArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *JobHandlerQueryJobArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerQueryJobArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *JobHandlerQueryJobArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewQueryJobRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *JobHandlerQueryJobArgs) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("QueryJob_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *JobHandlerQueryJobArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *JobHandlerQueryJobArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JobHandlerQueryJobArgs(%+v)", *p)
+
+}
+
+type JobHandlerQueryJobResult struct {
+	Success *QueryJobResponse `thrift:"success,0,optional"`
+}
+
+func NewJobHandlerQueryJobResult() *JobHandlerQueryJobResult {
+	return &JobHandlerQueryJobResult{}
+}
+
+func (p *JobHandlerQueryJobResult) InitDefault() {
+}
+
+var JobHandlerQueryJobResult_Success_DEFAULT *QueryJobResponse
+
+func (p *JobHandlerQueryJobResult) GetSuccess() (v *QueryJobResponse) {
+	if !p.IsSetSuccess() {
+		return JobHandlerQueryJobResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_JobHandlerQueryJobResult = map[int16]string{
+	0: "success",
+}
+
+func (p *JobHandlerQueryJobResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *JobHandlerQueryJobResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerQueryJobResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *JobHandlerQueryJobResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewQueryJobResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *JobHandlerQueryJobResult) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("QueryJob_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *JobHandlerQueryJobResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *JobHandlerQueryJobResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JobHandlerQueryJobResult(%+v)", *p)
+
+}
+
+type JobHandlerQueryJobDetailArgs struct {
+	Req *QueryJobDetailRequest `thrift:"req,1"`
+}
+
+func NewJobHandlerQueryJobDetailArgs() *JobHandlerQueryJobDetailArgs {
+	return &JobHandlerQueryJobDetailArgs{}
+}
+
+func (p *JobHandlerQueryJobDetailArgs) InitDefault() {
+}
+
+var JobHandlerQueryJobDetailArgs_Req_DEFAULT *QueryJobDetailRequest
+
+func (p *JobHandlerQueryJobDetailArgs) GetReq() (v *QueryJobDetailRequest) {
+	if !p.IsSetReq() {
+		return JobHandlerQueryJobDetailArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_JobHandlerQueryJobDetailArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *JobHandlerQueryJobDetailArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *JobHandlerQueryJobDetailArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerQueryJobDetailArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *JobHandlerQueryJobDetailArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewQueryJobDetailRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *JobHandlerQueryJobDetailArgs) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("QueryJobDetail_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *JobHandlerQueryJobDetailArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *JobHandlerQueryJobDetailArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JobHandlerQueryJobDetailArgs(%+v)", *p)
+
+}
+
+type JobHandlerQueryJobDetailResult struct {
+	Success *QueryJobDetailResponse `thrift:"success,0,optional"`
+}
+
+func NewJobHandlerQueryJobDetailResult() *JobHandlerQueryJobDetailResult {
+	return &JobHandlerQueryJobDetailResult{}
+}
+
+func (p *JobHandlerQueryJobDetailResult) InitDefault() {
+}
+
+var JobHandlerQueryJobDetailResult_Success_DEFAULT *QueryJobDetailResponse
+
+func (p *JobHandlerQueryJobDetailResult) GetSuccess() (v *QueryJobDetailResponse) {
+	if !p.IsSetSuccess() {
+		return JobHandlerQueryJobDetailResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_JobHandlerQueryJobDetailResult = map[int16]string{
+	0: "success",
+}
+
+func (p *JobHandlerQueryJobDetailResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *JobHandlerQueryJobDetailResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerQueryJobDetailResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *JobHandlerQueryJobDetailResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewQueryJobDetailResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *JobHandlerQueryJobDetailResult) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("QueryJobDetail_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *JobHandlerQueryJobDetailResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *JobHandlerQueryJobDetailResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JobHandlerQueryJobDetailResult(%+v)", *p)
+
+}
+
+type JobHandlerQueryJobEventsArgs struct {
+	Req *QueryJobEventsRequest `thrift:"req,1"`
+}
+
+func NewJobHandlerQueryJobEventsArgs() *JobHandlerQueryJobEventsArgs {
+	return &JobHandlerQueryJobEventsArgs{}
+}
+
+func (p *JobHandlerQueryJobEventsArgs) InitDefault() {
+}
+
+var JobHandlerQueryJobEventsArgs_Req_DEFAULT *QueryJobEventsRequest
+
+func (p *JobHandlerQueryJobEventsArgs) GetReq() (v *QueryJobEventsRequest) {
+	if !p.IsSetReq() {
+		return JobHandlerQueryJobEventsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_JobHandlerQueryJobEventsArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *JobHandlerQueryJobEventsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *JobHandlerQueryJobEventsArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerQueryJobEventsArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *JobHandlerQueryJobEventsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewQueryJobEventsRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *JobHandlerQueryJobEventsArgs) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("QueryJobEvents_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *JobHandlerQueryJobEventsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *JobHandlerQueryJobEventsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JobHandlerQueryJobEventsArgs(%+v)", *p)
+
+}
+
+type JobHandlerQueryJobEventsResult struct {
+	Success *QueryJobEventsResponse `thrift:"success,0,optional"`
+}
+
+func NewJobHandlerQueryJobEventsResult() *JobHandlerQueryJobEventsResult {
+	return &JobHandlerQueryJobEventsResult{}
+}
+
+func (p *JobHandlerQueryJobEventsResult) InitDefault() {
+}
+
+var JobHandlerQueryJobEventsResult_Success_DEFAULT *QueryJobEventsResponse
+
+func (p *JobHandlerQueryJobEventsResult) GetSuccess() (v *QueryJobEventsResponse) {
+	if !p.IsSetSuccess() {
+		return JobHandlerQueryJobEventsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_JobHandlerQueryJobEventsResult = map[int16]string{
+	0: "success",
+}
+
+func (p *JobHandlerQueryJobEventsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *JobHandlerQueryJobEventsResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerQueryJobEventsResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *JobHandlerQueryJobEventsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewQueryJobEventsResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *JobHandlerQueryJobEventsResult) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("QueryJobEvents_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if processor, ok := p.GetProcessorFunction(name); ok {
-		return processor.Process(ctx, seqId, iprot, oprot)
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
 	}
-	iprot.Skip(thrift.STRUCT)
-	iprot.ReadMessageEnd()
-	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
-	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
-	x.Write(oprot)
-	oprot.WriteMessageEnd()
-	oprot.Flush(ctx)
-	return false, x
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *JobHandlerQueryJobEventsResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *JobHandlerQueryJobEventsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JobHandlerQueryJobEventsResult(%+v)", *p)
+
+}
+
+type JobHandlerQueryJobBuildLogsArgs struct {
+	Req *QueryJobBuildLogsRequest `thrift:"req,1"`
+}
+
+func NewJobHandlerQueryJobBuildLogsArgs() *JobHandlerQueryJobBuildLogsArgs {
+	return &JobHandlerQueryJobBuildLogsArgs{}
+}
+
+func (p *JobHandlerQueryJobBuildLogsArgs) InitDefault() {
+}
+
+var JobHandlerQueryJobBuildLogsArgs_Req_DEFAULT *QueryJobBuildLogsRequest
+
+func (p *JobHandlerQueryJobBuildLogsArgs) GetReq() (v *QueryJobBuildLogsRequest) {
+	if !p.IsSetReq() {
+		return JobHandlerQueryJobBuildLogsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_JobHandlerQueryJobBuildLogsArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *JobHandlerQueryJobBuildLogsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *JobHandlerQueryJobBuildLogsArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerQueryJobBuildLogsArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *JobHandlerQueryJobBuildLogsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewQueryJobBuildLogsRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *JobHandlerQueryJobBuildLogsArgs) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("QueryJobBuildLogs_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *JobHandlerQueryJobBuildLogsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *JobHandlerQueryJobBuildLogsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JobHandlerQueryJobBuildLogsArgs(%+v)", *p)
+
+}
+
+type JobHandlerQueryJobBuildLogsResult struct {
+	Success *QueryJobBuildLogsResponse `thrift:"success,0,optional"`
 }
 
-type jobHandlerProcessorSubmitJob struct {
-	handler JobHandler
+func NewJobHandlerQueryJobBuildLogsResult() *JobHandlerQueryJobBuildLogsResult {
+	return &JobHandlerQueryJobBuildLogsResult{}
+}
+
+func (p *JobHandlerQueryJobBuildLogsResult) InitDefault() {
+}
+
+var JobHandlerQueryJobBuildLogsResult_Success_DEFAULT *QueryJobBuildLogsResponse
+
+func (p *JobHandlerQueryJobBuildLogsResult) GetSuccess() (v *QueryJobBuildLogsResponse) {
+	if !p.IsSetSuccess() {
+		return JobHandlerQueryJobBuildLogsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_JobHandlerQueryJobBuildLogsResult = map[int16]string{
+	0: "success",
+}
+
+func (p *JobHandlerQueryJobBuildLogsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *JobHandlerQueryJobBuildLogsResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerQueryJobBuildLogsResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *jobHandlerProcessorSubmitJob) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := JobHandlerSubmitJobArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("SubmitJob", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *JobHandlerQueryJobBuildLogsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewQueryJobBuildLogsResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Success = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := JobHandlerSubmitJobResult{}
-	var retval *SubmitJobResponse
-	if retval, err2 = p.handler.SubmitJob(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SubmitJob: "+err2.Error())
-		oprot.WriteMessageBegin("SubmitJob", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *JobHandlerQueryJobBuildLogsResult) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("QueryJobBuildLogs_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("SubmitJob", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *JobHandlerQueryJobBuildLogsResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *JobHandlerQueryJobBuildLogsResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return true, err
+	return fmt.Sprintf("JobHandlerQueryJobBuildLogsResult(%+v)", *p)
+
 }
 
-type jobHandlerProcessorQueryJob struct {
-	handler JobHandler
+type JobHandlerDeleteJobArgs struct {
+	Req *DeleteJobRequest `thrift:"req,1"`
 }
 
-func (p *jobHandlerProcessorQueryJob) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := JobHandlerQueryJobArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("QueryJob", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func NewJobHandlerDeleteJobArgs() *JobHandlerDeleteJobArgs {
+	return &JobHandlerDeleteJobArgs{}
+}
+
+func (p *JobHandlerDeleteJobArgs) InitDefault() {
+}
+
+var JobHandlerDeleteJobArgs_Req_DEFAULT *DeleteJobRequest
+
+func (p *JobHandlerDeleteJobArgs) GetReq() (v *DeleteJobRequest) {
+	if !p.IsSetReq() {
+		return JobHandlerDeleteJobArgs_Req_DEFAULT
 	}
+	return p.Req
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := JobHandlerQueryJobResult{}
-	var retval *QueryJobResponse
-	if retval, err2 = p.handler.QueryJob(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing QueryJob: "+err2.Error())
-		oprot.WriteMessageBegin("QueryJob", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+var fieldIDToName_JobHandlerDeleteJobArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *JobHandlerDeleteJobArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *JobHandlerDeleteJobArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("QueryJob", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerDeleteJobArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *JobHandlerDeleteJobArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewDeleteJobRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	p.Req = _field
+	return nil
+}
+
+func (p *JobHandlerDeleteJobArgs) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DeleteJob_args"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err != nil {
-		return
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *JobHandlerDeleteJobArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *JobHandlerDeleteJobArgs) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return true, err
+	return fmt.Sprintf("JobHandlerDeleteJobArgs(%+v)", *p)
+
 }
 
-type jobHandlerProcessorDeleteJob struct {
-	handler JobHandler
+type JobHandlerDeleteJobResult struct {
+	Success *DeleteJobResponse `thrift:"success,0,optional"`
 }
 
-func (p *jobHandlerProcessorDeleteJob) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := JobHandlerDeleteJobArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("DeleteJob", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func NewJobHandlerDeleteJobResult() *JobHandlerDeleteJobResult {
+	return &JobHandlerDeleteJobResult{}
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := JobHandlerDeleteJobResult{}
-	var retval *DeleteJobResponse
-	if retval, err2 = p.handler.DeleteJob(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DeleteJob: "+err2.Error())
-		oprot.WriteMessageBegin("DeleteJob", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("DeleteJob", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
+func (p *JobHandlerDeleteJobResult) InitDefault() {
+}
+
+var JobHandlerDeleteJobResult_Success_DEFAULT *DeleteJobResponse
+
+func (p *JobHandlerDeleteJobResult) GetSuccess() (v *DeleteJobResponse) {
+	if !p.IsSetSuccess() {
+		return JobHandlerDeleteJobResult_Success_DEFAULT
 	}
-	return true, err
+	return p.Success
 }
 
-type jobHandlerProcessorDownloadJobOutput struct {
-	handler JobHandler
+var fieldIDToName_JobHandlerDeleteJobResult = map[int16]string{
+	0: "success",
 }
 
-func (p *jobHandlerProcessorDownloadJobOutput) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := JobHandlerDownloadJobOutputArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("DownloadJobOutput", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func (p *JobHandlerDeleteJobResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := JobHandlerDownloadJobOutputResult{}
-	var retval *DownloadJobOutputResponse
-	if retval, err2 = p.handler.DownloadJobOutput(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DownloadJobOutput: "+err2.Error())
-		oprot.WriteMessageBegin("DownloadJobOutput", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("DownloadJobOutput", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+func (p *JobHandlerDeleteJobResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type jobHandlerProcessorQueryJobAttestationReport struct {
-	handler JobHandler
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerDeleteJobResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *jobHandlerProcessorQueryJobAttestationReport) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := JobHandlerQueryJobAttestationReportArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("QueryJobAttestationReport", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *JobHandlerDeleteJobResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewDeleteJobResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Success = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := JobHandlerQueryJobAttestationReportResult{}
-	var retval *QueryJobAttestationResponse
-	if retval, err2 = p.handler.QueryJobAttestationReport(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing QueryJobAttestationReport: "+err2.Error())
-		oprot.WriteMessageBegin("QueryJobAttestationReport", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *JobHandlerDeleteJobResult) Write(oprot thrift.TProtocol) (err error) {
+
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DeleteJob_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("QueryJobAttestationReport", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *JobHandlerDeleteJobResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *JobHandlerDeleteJobResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return true, err
+	return fmt.Sprintf("JobHandlerDeleteJobResult(%+v)", *p)
+
 }
 
-type JobHandlerSubmitJobArgs struct {
-	Req *SubmitJobRequest `thrift:"req,1"`
+type JobHandlerCancelJobArgs struct {
+	Req *CancelJobRequest `thrift:"req,1"`
 }
 
-func NewJobHandlerSubmitJobArgs() *JobHandlerSubmitJobArgs {
-	return &JobHandlerSubmitJobArgs{}
+func NewJobHandlerCancelJobArgs() *JobHandlerCancelJobArgs {
+	return &JobHandlerCancelJobArgs{}
 }
 
-func (p *JobHandlerSubmitJobArgs) InitDefault() {
+func (p *JobHandlerCancelJobArgs) InitDefault() {
 }
 
-var JobHandlerSubmitJobArgs_Req_DEFAULT *SubmitJobRequest
+var JobHandlerCancelJobArgs_Req_DEFAULT *CancelJobRequest
 
-func (p *JobHandlerSubmitJobArgs) GetReq() (v *SubmitJobRequest) {
+func (p *JobHandlerCancelJobArgs) GetReq() (v *CancelJobRequest) {
 	if !p.IsSetReq() {
-		return JobHandlerSubmitJobArgs_Req_DEFAULT
+		return JobHandlerCancelJobArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_JobHandlerSubmitJobArgs = map[int16]string{
+var fieldIDToName_JobHandlerCancelJobArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *JobHandlerSubmitJobArgs) IsSetReq() bool {
+func (p *JobHandlerCancelJobArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *JobHandlerSubmitJobArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *JobHandlerCancelJobArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3633,7 +11366,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerSubmitJobArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerCancelJobArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3643,8 +11376,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *JobHandlerSubmitJobArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewSubmitJobRequest()
+func (p *JobHandlerCancelJobArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewCancelJobRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -3652,10 +11385,10 @@ func (p *JobHandlerSubmitJobArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *JobHandlerSubmitJobArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *JobHandlerCancelJobArgs) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SubmitJob_args"); err != nil {
+	if err = oprot.WriteStructBegin("CancelJob_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3681,7 +11414,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *JobHandlerSubmitJobArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *JobHandlerCancelJobArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -3698,43 +11431,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *JobHandlerSubmitJobArgs) String() string {
+func (p *JobHandlerCancelJobArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("JobHandlerSubmitJobArgs(%+v)", *p)
+	return fmt.Sprintf("JobHandlerCancelJobArgs(%+v)", *p)
 
 }
 
-type JobHandlerSubmitJobResult struct {
-	Success *SubmitJobResponse `thrift:"success,0,optional"`
+type JobHandlerCancelJobResult struct {
+	Success *CancelJobResponse `thrift:"success,0,optional"`
 }
 
-func NewJobHandlerSubmitJobResult() *JobHandlerSubmitJobResult {
-	return &JobHandlerSubmitJobResult{}
+func NewJobHandlerCancelJobResult() *JobHandlerCancelJobResult {
+	return &JobHandlerCancelJobResult{}
 }
 
-func (p *JobHandlerSubmitJobResult) InitDefault() {
+func (p *JobHandlerCancelJobResult) InitDefault() {
 }
 
-var JobHandlerSubmitJobResult_Success_DEFAULT *SubmitJobResponse
+var JobHandlerCancelJobResult_Success_DEFAULT *CancelJobResponse
 
-func (p *JobHandlerSubmitJobResult) GetSuccess() (v *SubmitJobResponse) {
+func (p *JobHandlerCancelJobResult) GetSuccess() (v *CancelJobResponse) {
 	if !p.IsSetSuccess() {
-		return JobHandlerSubmitJobResult_Success_DEFAULT
+		return JobHandlerCancelJobResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_JobHandlerSubmitJobResult = map[int16]string{
+var fieldIDToName_JobHandlerCancelJobResult = map[int16]string{
 	0: "success",
 }
 
-func (p *JobHandlerSubmitJobResult) IsSetSuccess() bool {
+func (p *JobHandlerCancelJobResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *JobHandlerSubmitJobResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *JobHandlerCancelJobResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3780,7 +11513,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerSubmitJobResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerCancelJobResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3790,8 +11523,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *JobHandlerSubmitJobResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewSubmitJobResponse()
+func (p *JobHandlerCancelJobResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewCancelJobResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -3799,10 +11532,10 @@ func (p *JobHandlerSubmitJobResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *JobHandlerSubmitJobResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *JobHandlerCancelJobResult) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SubmitJob_result"); err != nil {
+	if err = oprot.WriteStructBegin("CancelJob_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3828,7 +11561,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *JobHandlerSubmitJobResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *JobHandlerCancelJobResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -3847,43 +11580,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *JobHandlerSubmitJobResult) String() string {
+func (p *JobHandlerCancelJobResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("JobHandlerSubmitJobResult(%+v)", *p)
+	return fmt.Sprintf("JobHandlerCancelJobResult(%+v)", *p)
 
 }
 
-type JobHandlerQueryJobArgs struct {
-	Req *QueryJobRequest `thrift:"req,1"`
+type JobHandlerDownloadJobOutputArgs struct {
+	Req *DownloadJobOutputRequest `thrift:"req,1"`
 }
 
-func NewJobHandlerQueryJobArgs() *JobHandlerQueryJobArgs {
-	return &JobHandlerQueryJobArgs{}
+func NewJobHandlerDownloadJobOutputArgs() *JobHandlerDownloadJobOutputArgs {
+	return &JobHandlerDownloadJobOutputArgs{}
 }
 
-func (p *JobHandlerQueryJobArgs) InitDefault() {
+func (p *JobHandlerDownloadJobOutputArgs) InitDefault() {
 }
 
-var JobHandlerQueryJobArgs_Req_DEFAULT *QueryJobRequest
+var JobHandlerDownloadJobOutputArgs_Req_DEFAULT *DownloadJobOutputRequest
 
-func (p *JobHandlerQueryJobArgs) GetReq() (v *QueryJobRequest) {
+func (p *JobHandlerDownloadJobOutputArgs) GetReq() (v *DownloadJobOutputRequest) {
 	if !p.IsSetReq() {
-		return JobHandlerQueryJobArgs_Req_DEFAULT
+		return JobHandlerDownloadJobOutputArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_JobHandlerQueryJobArgs = map[int16]string{
+var fieldIDToName_JobHandlerDownloadJobOutputArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *JobHandlerQueryJobArgs) IsSetReq() bool {
+func (p *JobHandlerDownloadJobOutputArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *JobHandlerQueryJobArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *JobHandlerDownloadJobOutputArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3929,7 +11662,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerQueryJobArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerDownloadJobOutputArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3939,8 +11672,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *JobHandlerQueryJobArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewQueryJobRequest()
+func (p *JobHandlerDownloadJobOutputArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewDownloadJobOutputRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -3948,10 +11681,10 @@ func (p *JobHandlerQueryJobArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *JobHandlerQueryJobArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *JobHandlerDownloadJobOutputArgs) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("QueryJob_args"); err != nil {
+	if err = oprot.WriteStructBegin("DownloadJobOutput_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3977,7 +11710,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *JobHandlerQueryJobArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *JobHandlerDownloadJobOutputArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -3994,43 +11727,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *JobHandlerQueryJobArgs) String() string {
+func (p *JobHandlerDownloadJobOutputArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("JobHandlerQueryJobArgs(%+v)", *p)
+	return fmt.Sprintf("JobHandlerDownloadJobOutputArgs(%+v)", *p)
 
 }
 
-type JobHandlerQueryJobResult struct {
-	Success *QueryJobResponse `thrift:"success,0,optional"`
+type JobHandlerDownloadJobOutputResult struct {
+	Success *DownloadJobOutputResponse `thrift:"success,0,optional"`
 }
 
-func NewJobHandlerQueryJobResult() *JobHandlerQueryJobResult {
-	return &JobHandlerQueryJobResult{}
+func NewJobHandlerDownloadJobOutputResult() *JobHandlerDownloadJobOutputResult {
+	return &JobHandlerDownloadJobOutputResult{}
 }
 
-func (p *JobHandlerQueryJobResult) InitDefault() {
+func (p *JobHandlerDownloadJobOutputResult) InitDefault() {
 }
 
-var JobHandlerQueryJobResult_Success_DEFAULT *QueryJobResponse
+var JobHandlerDownloadJobOutputResult_Success_DEFAULT *DownloadJobOutputResponse
 
-func (p *JobHandlerQueryJobResult) GetSuccess() (v *QueryJobResponse) {
+func (p *JobHandlerDownloadJobOutputResult) GetSuccess() (v *DownloadJobOutputResponse) {
 	if !p.IsSetSuccess() {
-		return JobHandlerQueryJobResult_Success_DEFAULT
+		return JobHandlerDownloadJobOutputResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_JobHandlerQueryJobResult = map[int16]string{
+var fieldIDToName_JobHandlerDownloadJobOutputResult = map[int16]string{
 	0: "success",
 }
 
-func (p *JobHandlerQueryJobResult) IsSetSuccess() bool {
+func (p *JobHandlerDownloadJobOutputResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *JobHandlerQueryJobResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *JobHandlerDownloadJobOutputResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4076,7 +11809,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerQueryJobResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerDownloadJobOutputResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4086,8 +11819,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *JobHandlerQueryJobResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewQueryJobResponse()
+func (p *JobHandlerDownloadJobOutputResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewDownloadJobOutputResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -4095,10 +11828,10 @@ func (p *JobHandlerQueryJobResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *JobHandlerQueryJobResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *JobHandlerDownloadJobOutputResult) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("QueryJob_result"); err != nil {
+	if err = oprot.WriteStructBegin("DownloadJobOutput_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4124,7 +11857,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *JobHandlerQueryJobResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *JobHandlerDownloadJobOutputResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -4143,43 +11876,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *JobHandlerQueryJobResult) String() string {
+func (p *JobHandlerDownloadJobOutputResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("JobHandlerQueryJobResult(%+v)", *p)
+	return fmt.Sprintf("JobHandlerDownloadJobOutputResult(%+v)", *p)
 
 }
 
-type JobHandlerDeleteJobArgs struct {
-	Req *DeleteJobRequest `thrift:"req,1"`
+type JobHandlerQueryJobAttestationReportArgs struct {
+	Req *QueryJobAttestationRequest `thrift:"req,1"`
 }
 
-func NewJobHandlerDeleteJobArgs() *JobHandlerDeleteJobArgs {
-	return &JobHandlerDeleteJobArgs{}
+func NewJobHandlerQueryJobAttestationReportArgs() *JobHandlerQueryJobAttestationReportArgs {
+	return &JobHandlerQueryJobAttestationReportArgs{}
 }
 
-func (p *JobHandlerDeleteJobArgs) InitDefault() {
+func (p *JobHandlerQueryJobAttestationReportArgs) InitDefault() {
 }
 
-var JobHandlerDeleteJobArgs_Req_DEFAULT *DeleteJobRequest
+var JobHandlerQueryJobAttestationReportArgs_Req_DEFAULT *QueryJobAttestationRequest
 
-func (p *JobHandlerDeleteJobArgs) GetReq() (v *DeleteJobRequest) {
+func (p *JobHandlerQueryJobAttestationReportArgs) GetReq() (v *QueryJobAttestationRequest) {
 	if !p.IsSetReq() {
-		return JobHandlerDeleteJobArgs_Req_DEFAULT
+		return JobHandlerQueryJobAttestationReportArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_JobHandlerDeleteJobArgs = map[int16]string{
+var fieldIDToName_JobHandlerQueryJobAttestationReportArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *JobHandlerDeleteJobArgs) IsSetReq() bool {
+func (p *JobHandlerQueryJobAttestationReportArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *JobHandlerDeleteJobArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *JobHandlerQueryJobAttestationReportArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4225,7 +11958,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerDeleteJobArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerQueryJobAttestationReportArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4235,8 +11968,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *JobHandlerDeleteJobArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewDeleteJobRequest()
+func (p *JobHandlerQueryJobAttestationReportArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewQueryJobAttestationRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -4244,10 +11977,10 @@ func (p *JobHandlerDeleteJobArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *JobHandlerDeleteJobArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *JobHandlerQueryJobAttestationReportArgs) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeleteJob_args"); err != nil {
+	if err = oprot.WriteStructBegin("QueryJobAttestationReport_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4273,7 +12006,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *JobHandlerDeleteJobArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *JobHandlerQueryJobAttestationReportArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -4290,43 +12023,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *JobHandlerDeleteJobArgs) String() string {
+func (p *JobHandlerQueryJobAttestationReportArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("JobHandlerDeleteJobArgs(%+v)", *p)
+	return fmt.Sprintf("JobHandlerQueryJobAttestationReportArgs(%+v)", *p)
 
 }
 
-type JobHandlerDeleteJobResult struct {
-	Success *DeleteJobResponse `thrift:"success,0,optional"`
+type JobHandlerQueryJobAttestationReportResult struct {
+	Success *QueryJobAttestationResponse `thrift:"success,0,optional"`
 }
 
-func NewJobHandlerDeleteJobResult() *JobHandlerDeleteJobResult {
-	return &JobHandlerDeleteJobResult{}
+func NewJobHandlerQueryJobAttestationReportResult() *JobHandlerQueryJobAttestationReportResult {
+	return &JobHandlerQueryJobAttestationReportResult{}
 }
 
-func (p *JobHandlerDeleteJobResult) InitDefault() {
+func (p *JobHandlerQueryJobAttestationReportResult) InitDefault() {
 }
 
-var JobHandlerDeleteJobResult_Success_DEFAULT *DeleteJobResponse
+var JobHandlerQueryJobAttestationReportResult_Success_DEFAULT *QueryJobAttestationResponse
 
-func (p *JobHandlerDeleteJobResult) GetSuccess() (v *DeleteJobResponse) {
+func (p *JobHandlerQueryJobAttestationReportResult) GetSuccess() (v *QueryJobAttestationResponse) {
 	if !p.IsSetSuccess() {
-		return JobHandlerDeleteJobResult_Success_DEFAULT
+		return JobHandlerQueryJobAttestationReportResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_JobHandlerDeleteJobResult = map[int16]string{
+var fieldIDToName_JobHandlerQueryJobAttestationReportResult = map[int16]string{
 	0: "success",
 }
 
-func (p *JobHandlerDeleteJobResult) IsSetSuccess() bool {
+func (p *JobHandlerQueryJobAttestationReportResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *JobHandlerDeleteJobResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *JobHandlerQueryJobAttestationReportResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4372,7 +12105,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerDeleteJobResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerQueryJobAttestationReportResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4382,8 +12115,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *JobHandlerDeleteJobResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewDeleteJobResponse()
+func (p *JobHandlerQueryJobAttestationReportResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewQueryJobAttestationResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -4391,10 +12124,10 @@ func (p *JobHandlerDeleteJobResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *JobHandlerDeleteJobResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *JobHandlerQueryJobAttestationReportResult) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeleteJob_result"); err != nil {
+	if err = oprot.WriteStructBegin("QueryJobAttestationReport_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4420,7 +12153,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *JobHandlerDeleteJobResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *JobHandlerQueryJobAttestationReportResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -4439,43 +12172,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *JobHandlerDeleteJobResult) String() string {
+func (p *JobHandlerQueryJobAttestationReportResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("JobHandlerDeleteJobResult(%+v)", *p)
+	return fmt.Sprintf("JobHandlerQueryJobAttestationReportResult(%+v)", *p)
 
 }
 
-type JobHandlerDownloadJobOutputArgs struct {
-	Req *DownloadJobOutputRequest `thrift:"req,1"`
+type JobHandlerVerifyJobAttestationArgs struct {
+	Req *VerifyJobAttestationRequest `thrift:"req,1"`
 }
 
-func NewJobHandlerDownloadJobOutputArgs() *JobHandlerDownloadJobOutputArgs {
-	return &JobHandlerDownloadJobOutputArgs{}
+func NewJobHandlerVerifyJobAttestationArgs() *JobHandlerVerifyJobAttestationArgs {
+	return &JobHandlerVerifyJobAttestationArgs{}
 }
 
-func (p *JobHandlerDownloadJobOutputArgs) InitDefault() {
+func (p *JobHandlerVerifyJobAttestationArgs) InitDefault() {
 }
 
-var JobHandlerDownloadJobOutputArgs_Req_DEFAULT *DownloadJobOutputRequest
+var JobHandlerVerifyJobAttestationArgs_Req_DEFAULT *VerifyJobAttestationRequest
 
-func (p *JobHandlerDownloadJobOutputArgs) GetReq() (v *DownloadJobOutputRequest) {
+func (p *JobHandlerVerifyJobAttestationArgs) GetReq() (v *VerifyJobAttestationRequest) {
 	if !p.IsSetReq() {
-		return JobHandlerDownloadJobOutputArgs_Req_DEFAULT
+		return JobHandlerVerifyJobAttestationArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_JobHandlerDownloadJobOutputArgs = map[int16]string{
+var fieldIDToName_JobHandlerVerifyJobAttestationArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *JobHandlerDownloadJobOutputArgs) IsSetReq() bool {
+func (p *JobHandlerVerifyJobAttestationArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *JobHandlerDownloadJobOutputArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *JobHandlerVerifyJobAttestationArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4521,7 +12254,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerDownloadJobOutputArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerVerifyJobAttestationArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4531,8 +12264,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *JobHandlerDownloadJobOutputArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewDownloadJobOutputRequest()
+func (p *JobHandlerVerifyJobAttestationArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewVerifyJobAttestationRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -4540,10 +12273,10 @@ func (p *JobHandlerDownloadJobOutputArgs) ReadField1(iprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *JobHandlerDownloadJobOutputArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *JobHandlerVerifyJobAttestationArgs) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DownloadJobOutput_args"); err != nil {
+	if err = oprot.WriteStructBegin("VerifyJobAttestation_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4569,7 +12302,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *JobHandlerDownloadJobOutputArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *JobHandlerVerifyJobAttestationArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -4586,43 +12319,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *JobHandlerDownloadJobOutputArgs) String() string {
+func (p *JobHandlerVerifyJobAttestationArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("JobHandlerDownloadJobOutputArgs(%+v)", *p)
+	return fmt.Sprintf("JobHandlerVerifyJobAttestationArgs(%+v)", *p)
 
 }
 
-type JobHandlerDownloadJobOutputResult struct {
-	Success *DownloadJobOutputResponse `thrift:"success,0,optional"`
+type JobHandlerVerifyJobAttestationResult struct {
+	Success *VerifyJobAttestationResponse `thrift:"success,0,optional"`
 }
 
-func NewJobHandlerDownloadJobOutputResult() *JobHandlerDownloadJobOutputResult {
-	return &JobHandlerDownloadJobOutputResult{}
+func NewJobHandlerVerifyJobAttestationResult() *JobHandlerVerifyJobAttestationResult {
+	return &JobHandlerVerifyJobAttestationResult{}
 }
 
-func (p *JobHandlerDownloadJobOutputResult) InitDefault() {
+func (p *JobHandlerVerifyJobAttestationResult) InitDefault() {
 }
 
-var JobHandlerDownloadJobOutputResult_Success_DEFAULT *DownloadJobOutputResponse
+var JobHandlerVerifyJobAttestationResult_Success_DEFAULT *VerifyJobAttestationResponse
 
-func (p *JobHandlerDownloadJobOutputResult) GetSuccess() (v *DownloadJobOutputResponse) {
+func (p *JobHandlerVerifyJobAttestationResult) GetSuccess() (v *VerifyJobAttestationResponse) {
 	if !p.IsSetSuccess() {
-		return JobHandlerDownloadJobOutputResult_Success_DEFAULT
+		return JobHandlerVerifyJobAttestationResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_JobHandlerDownloadJobOutputResult = map[int16]string{
+var fieldIDToName_JobHandlerVerifyJobAttestationResult = map[int16]string{
 	0: "success",
 }
 
-func (p *JobHandlerDownloadJobOutputResult) IsSetSuccess() bool {
+func (p *JobHandlerVerifyJobAttestationResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *JobHandlerDownloadJobOutputResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *JobHandlerVerifyJobAttestationResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4668,7 +12401,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerDownloadJobOutputResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerVerifyJobAttestationResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4678,8 +12411,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *JobHandlerDownloadJobOutputResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewDownloadJobOutputResponse()
+func (p *JobHandlerVerifyJobAttestationResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewVerifyJobAttestationResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -4687,10 +12420,10 @@ func (p *JobHandlerDownloadJobOutputResult) ReadField0(iprot thrift.TProtocol) e
 	return nil
 }
 
-func (p *JobHandlerDownloadJobOutputResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *JobHandlerVerifyJobAttestationResult) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DownloadJobOutput_result"); err != nil {
+	if err = oprot.WriteStructBegin("VerifyJobAttestation_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4716,7 +12449,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *JobHandlerDownloadJobOutputResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *JobHandlerVerifyJobAttestationResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -4735,43 +12468,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *JobHandlerDownloadJobOutputResult) String() string {
+func (p *JobHandlerVerifyJobAttestationResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("JobHandlerDownloadJobOutputResult(%+v)", *p)
+	return fmt.Sprintf("JobHandlerVerifyJobAttestationResult(%+v)", *p)
 
 }
 
-type JobHandlerQueryJobAttestationReportArgs struct {
-	Req *QueryJobAttestationRequest `thrift:"req,1"`
+type JobHandlerQueryUsageArgs struct {
+	Req *QueryUsageRequest `thrift:"req,1"`
 }
 
-func NewJobHandlerQueryJobAttestationReportArgs() *JobHandlerQueryJobAttestationReportArgs {
-	return &JobHandlerQueryJobAttestationReportArgs{}
+func NewJobHandlerQueryUsageArgs() *JobHandlerQueryUsageArgs {
+	return &JobHandlerQueryUsageArgs{}
 }
 
-func (p *JobHandlerQueryJobAttestationReportArgs) InitDefault() {
+func (p *JobHandlerQueryUsageArgs) InitDefault() {
 }
 
-var JobHandlerQueryJobAttestationReportArgs_Req_DEFAULT *QueryJobAttestationRequest
+var JobHandlerQueryUsageArgs_Req_DEFAULT *QueryUsageRequest
 
-func (p *JobHandlerQueryJobAttestationReportArgs) GetReq() (v *QueryJobAttestationRequest) {
+func (p *JobHandlerQueryUsageArgs) GetReq() (v *QueryUsageRequest) {
 	if !p.IsSetReq() {
-		return JobHandlerQueryJobAttestationReportArgs_Req_DEFAULT
+		return JobHandlerQueryUsageArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_JobHandlerQueryJobAttestationReportArgs = map[int16]string{
+var fieldIDToName_JobHandlerQueryUsageArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *JobHandlerQueryJobAttestationReportArgs) IsSetReq() bool {
+func (p *JobHandlerQueryUsageArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *JobHandlerQueryJobAttestationReportArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *JobHandlerQueryUsageArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4817,7 +12550,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerQueryJobAttestationReportArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerQueryUsageArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4827,8 +12560,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *JobHandlerQueryJobAttestationReportArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewQueryJobAttestationRequest()
+func (p *JobHandlerQueryUsageArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewQueryUsageRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -4836,10 +12569,10 @@ func (p *JobHandlerQueryJobAttestationReportArgs) ReadField1(iprot thrift.TProto
 	return nil
 }
 
-func (p *JobHandlerQueryJobAttestationReportArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *JobHandlerQueryUsageArgs) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("QueryJobAttestationReport_args"); err != nil {
+	if err = oprot.WriteStructBegin("QueryUsage_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4865,7 +12598,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *JobHandlerQueryJobAttestationReportArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *JobHandlerQueryUsageArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -4882,43 +12615,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *JobHandlerQueryJobAttestationReportArgs) String() string {
+func (p *JobHandlerQueryUsageArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("JobHandlerQueryJobAttestationReportArgs(%+v)", *p)
+	return fmt.Sprintf("JobHandlerQueryUsageArgs(%+v)", *p)
 
 }
 
-type JobHandlerQueryJobAttestationReportResult struct {
-	Success *QueryJobAttestationResponse `thrift:"success,0,optional"`
+type JobHandlerQueryUsageResult struct {
+	Success *QueryUsageResponse `thrift:"success,0,optional"`
 }
 
-func NewJobHandlerQueryJobAttestationReportResult() *JobHandlerQueryJobAttestationReportResult {
-	return &JobHandlerQueryJobAttestationReportResult{}
+func NewJobHandlerQueryUsageResult() *JobHandlerQueryUsageResult {
+	return &JobHandlerQueryUsageResult{}
 }
 
-func (p *JobHandlerQueryJobAttestationReportResult) InitDefault() {
+func (p *JobHandlerQueryUsageResult) InitDefault() {
 }
 
-var JobHandlerQueryJobAttestationReportResult_Success_DEFAULT *QueryJobAttestationResponse
+var JobHandlerQueryUsageResult_Success_DEFAULT *QueryUsageResponse
 
-func (p *JobHandlerQueryJobAttestationReportResult) GetSuccess() (v *QueryJobAttestationResponse) {
+func (p *JobHandlerQueryUsageResult) GetSuccess() (v *QueryUsageResponse) {
 	if !p.IsSetSuccess() {
-		return JobHandlerQueryJobAttestationReportResult_Success_DEFAULT
+		return JobHandlerQueryUsageResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_JobHandlerQueryJobAttestationReportResult = map[int16]string{
+var fieldIDToName_JobHandlerQueryUsageResult = map[int16]string{
 	0: "success",
 }
 
-func (p *JobHandlerQueryJobAttestationReportResult) IsSetSuccess() bool {
+func (p *JobHandlerQueryUsageResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *JobHandlerQueryJobAttestationReportResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *JobHandlerQueryUsageResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4964,7 +12697,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerQueryJobAttestationReportResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_JobHandlerQueryUsageResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4974,8 +12707,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *JobHandlerQueryJobAttestationReportResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewQueryJobAttestationResponse()
+func (p *JobHandlerQueryUsageResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewQueryUsageResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -4983,10 +12716,10 @@ func (p *JobHandlerQueryJobAttestationReportResult) ReadField0(iprot thrift.TPro
 	return nil
 }
 
-func (p *JobHandlerQueryJobAttestationReportResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *JobHandlerQueryUsageResult) Write(oprot thrift.TProtocol) (err error) {
 
 	var fieldId int16
-	if err = oprot.WriteStructBegin("QueryJobAttestationReport_result"); err != nil {
+	if err = oprot.WriteStructBegin("QueryUsage_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5012,7 +12745,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *JobHandlerQueryJobAttestationReportResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *JobHandlerQueryUsageResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -5031,10 +12764,10 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *JobHandlerQueryJobAttestationReportResult) String() string {
+func (p *JobHandlerQueryUsageResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("JobHandlerQueryJobAttestationReportResult(%+v)", *p)
+	return fmt.Sprintf("JobHandlerQueryUsageResult(%+v)", *p)
 
 }
@@ -0,0 +1,78 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mw
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+
+	"github.com/manatee-project/manatee/app/api/biz/pkg/auth"
+	"github.com/manatee-project/manatee/app/api/biz/pkg/config"
+	"github.com/manatee-project/manatee/app/api/biz/pkg/errno"
+	"github.com/manatee-project/manatee/app/api/biz/pkg/utils"
+)
+
+const identityKey = "identity"
+
+var authenticator auth.Authenticator
+
+func Init() {
+	a, err := auth.GetAuthenticator(context.Background(), config.Get().Auth)
+	if err != nil {
+		panic(err)
+	}
+	if _, ok := a.(*auth.NoneAuthenticator); ok {
+		hlog.Warnf("[Auth Middleware]authentication is disabled, creators are trusted from requests")
+	}
+	authenticator = a
+}
+
+// Authentication verifies the Authorization header and stores the caller identity in the request context.
+func Authentication() app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		token := auth.ParseAuthorizationHeader(string(c.GetHeader("Authorization")))
+		identity, err := authenticator.Authenticate(ctx, token)
+		if err != nil {
+			hlog.Infof("[Auth Middleware]rejected request to %s: %+v", c.Path(), err)
+			utils.ReturnsJSONErrorWithStatus(c, http.StatusUnauthorized, err)
+			return
+		}
+		c.Set(identityKey, identity)
+		c.Next(ctx)
+	}
+}
+
+// GetIdentity returns the identity set by the Authentication middleware.
+func GetIdentity(c *app.RequestContext) (*auth.Identity, bool) {
+	v, ok := c.Get(identityKey)
+	if !ok {
+		return nil, false
+	}
+	identity, ok := v.(*auth.Identity)
+	return identity, ok
+}
+
+// AuthorizeCreator checks the caller against the creator of the request, see auth.Identity.AuthorizeCreator.
+// Requests that did not go through the Authentication middleware are denied.
+func AuthorizeCreator(c *app.RequestContext, creator string) (string, error) {
+	identity, ok := GetIdentity(c)
+	if !ok {
+		return "", errno.PermissionDeniedErr.WithMessage("request is not authenticated")
+	}
+	return identity.AuthorizeCreator(creator)
+}
@@ -0,0 +1,256 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package attestation verifies the attestation tokens issued to jobs running in Confidential Space.
+package attestation
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/manatee-project/manatee/app/api/biz/pkg/jwks"
+)
+
+const (
+	CheckSignature   = "signature"
+	CheckIssuer      = "issuer"
+	CheckAudience    = "audience"
+	CheckExpiry      = "expiry"
+	CheckNonce       = "nonce"
+	CheckImageDigest = "image_digest"
+	CheckSecureBoot  = "secure_boot"
+	CheckDebug       = "debug"
+)
+
+// Token types of the teeserver. PKI tokens carry the certificate chain of their signing key in the x5c header.
+const (
+	TokenTypeOIDC = "OIDC"
+	TokenTypePKI  = "PKI"
+)
+
+// debugDisabled is the dbgstat claim of production Confidential Space images.
+const debugDisabled = "disabled-since-boot"
+
+type Config struct {
+	// Issuer is the expected iss claim. If JWKSFile is empty, the keys are discovered from the issuer.
+	Issuer string
+	// JWKSFile is a local JSON Web Key Set used to verify token signatures.
+	JWKSFile string
+	// Audience is the expected aud claim. The claim is not checked if empty.
+	Audience string
+	// RootCertFile is a PEM bundle of the root certificates the chains of PKI tokens are verified against.
+	// The keys are then not discovered from the issuer, so that no JWKS is fetched.
+	RootCertFile string
+}
+
+// Expected holds the values a token must attest to.
+type Expected struct {
+	// Nonce must be one of the eat_nonce claims.
+	Nonce string
+	// ImageDigest is the digest of the workload image, with or without the sha256: prefix.
+	ImageDigest string
+	// Time is when the token must have been valid, now if zero.
+	Time time.Time
+	// Audience overrides the audience of the configuration, for tokens requested for another audience.
+	Audience string
+	// TokenType is the type the token was requested as, a PKI token must carry a certificate chain.
+	TokenType string
+}
+
+// Check is the result of a single verification step.
+type Check struct {
+	Name   string `json:"name"`
+	Passed bool   `json:"passed"`
+	Detail string `json:"detail"`
+}
+
+// Verdict is the outcome of verifying a token. The token is trusted only if every check passed.
+type Verdict struct {
+	Verified bool    `json:"verified"`
+	Checks   []Check `json:"checks"`
+}
+
+func (v *Verdict) add(name string, passed bool, format string, args ...interface{}) {
+	v.Checks = append(v.Checks, Check{Name: name, Passed: passed, Detail: fmt.Sprintf(format, args...)})
+}
+
+// Verifier verifies OIDC tokens with a JSON Web Key Set, and PKI tokens with root certificates.
+type Verifier struct {
+	keys   *jwks.KeySet
+	roots  *x509.CertPool
+	config Config
+	parser *jwt.Parser
+	// mock accepts the tokens of the mock issuer, which any workload holding the mock key can sign.
+	mock bool
+}
+
+func NewVerifier(ctx context.Context, config Config) (*Verifier, error) {
+	var keys *jwks.KeySet
+	var roots *x509.CertPool
+	var err error
+	if config.RootCertFile != "" {
+		if roots, err = LoadRootCertificates(config.RootCertFile); err != nil {
+			return nil, err
+		}
+	}
+	if config.JWKSFile != "" {
+		keys, err = jwks.LoadFile(config.JWKSFile)
+	} else if config.Issuer != "" && roots == nil {
+		keys, err = jwks.DiscoverKeySet(ctx, config.Issuer)
+	} else if roots == nil {
+		return nil, fmt.Errorf("a jwks file, an issuer or root certificates are required to verify attestation tokens")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return newVerifier(keys, roots, config), nil
+}
+
+func newVerifier(keys *jwks.KeySet, roots *x509.CertPool, config Config) *Verifier {
+	// claims are validated by Verify so that every failure is reported in the verdict
+	return &Verifier{
+		keys:   keys,
+		roots:  roots,
+		config: config,
+		parser: jwt.NewParser(
+			jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}),
+			jwt.WithoutClaimsValidation(),
+		),
+	}
+}
+
+// Verify checks the signature and claims of a token. The claims are only checked if the signature is valid.
+func (v *Verifier) Verify(token string, expected Expected) *Verdict {
+	if expected.Time.IsZero() {
+		expected.Time = time.Now()
+	}
+	verdict := &Verdict{}
+	claims := jwt.MapClaims{}
+	var signer string
+	keyfunc := func(t *jwt.Token) (interface{}, error) {
+		if x5c, ok := t.Header["x5c"]; ok {
+			if v.roots == nil {
+				return nil, fmt.Errorf("token has a certificate chain, but no root certificates are configured")
+			}
+			leaf, err := verifyChain(x5c, v.roots, expected.Time)
+			if err != nil {
+				return nil, err
+			}
+			signer = fmt.Sprintf("certificate %q chained to a trusted root", leaf.Subject.CommonName)
+			return leaf.PublicKey, nil
+		}
+		if expected.TokenType == TokenTypePKI {
+			return nil, fmt.Errorf("PKI token has no x5c certificate chain")
+		}
+		if v.keys == nil {
+			return nil, fmt.Errorf("token has no certificate chain, and no JSON Web Key Set is configured")
+		}
+		signer = "a trusted key"
+		return v.keys.Keyfunc(t)
+	}
+	if _, err := v.parser.ParseWithClaims(token, claims, keyfunc); err != nil {
+		verdict.add(CheckSignature, false, "invalid token: %v", err)
+		return verdict
+	}
+	verdict.add(CheckSignature, true, "signed by %s", signer)
+
+	iss, _ := claims.GetIssuer()
+	if iss == MockIssuer && !v.mock {
+		verdict.add(CheckIssuer, false, "issuer is %q, mock tokens are only accepted in mock mode", iss)
+	} else if v.config.Issuer != "" {
+		verdict.add(CheckIssuer, iss == v.config.Issuer, "issuer is %q", iss)
+	}
+	audience := v.config.Audience
+	if expected.Audience != "" {
+		audience = expected.Audience
+	}
+	if audience != "" {
+		aud, _ := claims.GetAudience()
+		verdict.add(CheckAudience, slices.Contains(aud, audience), "audience is %q, expected %q", []string(aud), audience)
+	}
+	ok, detail := checkExpiry(claims, expected.Time)
+	verdict.add(CheckExpiry, ok, "%s", detail)
+
+	nonces := stringClaims(claims["eat_nonce"])
+	verdict.add(CheckNonce, expected.Nonce != "" && slices.Contains(nonces, expected.Nonce),
+		"nonces are %q, expected %q", nonces, expected.Nonce)
+
+	digest := imageDigest(claims)
+	want := normalizeDigest(expected.ImageDigest)
+	verdict.add(CheckImageDigest, want != "" && digest == want, "image digest is %q, expected %q", digest, want)
+
+	secboot, _ := claims["secboot"].(bool)
+	verdict.add(CheckSecureBoot, secboot, "secure boot is %v", secboot)
+
+	dbgstat, _ := claims["dbgstat"].(string)
+	verdict.add(CheckDebug, dbgstat == debugDisabled, "debug status is %q", dbgstat)
+
+	verdict.Verified = true
+	for _, c := range verdict.Checks {
+		verdict.Verified = verdict.Verified && c.Passed
+	}
+	return verdict
+}
+
+// checkExpiry checks that the token was valid at the given time.
+func checkExpiry(claims jwt.MapClaims, at time.Time) (bool, string) {
+	exp, err := claims.GetExpirationTime()
+	if err != nil || exp == nil {
+		return false, "token has no valid exp claim"
+	}
+	if nbf, err := claims.GetNotBefore(); err == nil && nbf != nil && at.Before(nbf.Time) {
+		return false, fmt.Sprintf("token is not valid before %s", nbf.Time.UTC().Format(time.RFC3339))
+	}
+	if !at.Before(exp.Time) {
+		return false, fmt.Sprintf("token expired at %s", exp.Time.UTC().Format(time.RFC3339))
+	}
+	return true, fmt.Sprintf("token expires at %s", exp.Time.UTC().Format(time.RFC3339))
+}
+
+// imageDigest returns the submods.container.image_digest claim.
+func imageDigest(claims jwt.MapClaims) string {
+	submods, _ := claims["submods"].(map[string]interface{})
+	container, _ := submods["container"].(map[string]interface{})
+	digest, _ := container["image_digest"].(string)
+	return digest
+}
+
+func normalizeDigest(digest string) string {
+	if digest == "" || strings.Contains(digest, ":") {
+		return digest
+	}
+	return "sha256:" + digest
+}
+
+// stringClaims returns a claim that is either a string or a list of strings.
+func stringClaims(claim interface{}) []string {
+	switch c := claim.(type) {
+	case string:
+		return []string{c}
+	case []interface{}:
+		var values []string
+		for _, v := range c {
+			if s, ok := v.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+package attestation
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/manatee-project/manatee/app/api/biz/pkg/jwks"
+)
+
+const testDigest = "8f3a7c1e5d2b9a4f6e0c3b7d1a5e9f2c4b8d0a6e3f7c1b5d9a2e4f8c0b6d3a7e"
+
+func TestVerify(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jwk, err := jwks.NewJSONWebKey("test-key", &key.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := jwks.Marshal(jwk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jwksFile := filepath.Join(t.TempDir(), "jwks.json")
+	if err := os.WriteFile(jwksFile, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	v, err := NewVerifier(context.Background(), Config{
+		Issuer:   "https://confidentialcomputing.googleapis.com",
+		JWKSFile: jwksFile,
+		Audience: "https://research.tiktok.com/",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sign := func(claims jwt.MapClaims) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+		token.Header["kid"] = "test-key"
+		s, err := token.SignedString(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return s
+	}
+	valid := func() jwt.MapClaims {
+		return jwt.MapClaims{
+			"iss":       "https://confidentialcomputing.googleapis.com",
+			"aud":       "https://research.tiktok.com/",
+			"exp":       time.Now().Add(time.Hour).Unix(),
+			"eat_nonce": []string{"other", "d41d8cd98f00b204e9800998ecf8427e"},
+			"secboot":   true,
+			"dbgstat":   "disabled-since-boot",
+			"swname":    "CONFIDENTIAL_SPACE",
+			"submods": map[string]interface{}{
+				"container": map[string]interface{}{
+					"image_digest": "sha256:" + testDigest,
+				},
+			},
+		}
+	}
+	expected := Expected{Nonce: "d41d8cd98f00b204e9800998ecf8427e", ImageDigest: testDigest}
+
+	verdict := v.Verify(sign(valid()), expected)
+	if !verdict.Verified || len(verdict.Checks) != 8 {
+		t.Fatalf("expected a verified token, got %+v", verdict)
+	}
+	singleNonce := valid()
+	singleNonce["eat_nonce"] = "d41d8cd98f00b204e9800998ecf8427e"
+	if verdict := v.Verify(sign(singleNonce), expected); !verdict.Verified {
+		t.Errorf("expected a single nonce to be accepted, got %+v", verdict)
+	}
+	// a token verified after the job finished must have been valid when it finished
+	expired := valid()
+	expired["exp"] = time.Now().Add(-time.Hour).Unix()
+	if verdict := v.Verify(sign(expired), Expected{
+		Nonce:       expected.Nonce,
+		ImageDigest: expected.ImageDigest,
+		Time:        time.Now().Add(-2 * time.Hour),
+	}); !verdict.Verified {
+		t.Errorf("expected a token valid at the given time to be accepted, got %+v", verdict)
+	}
+
+	otherAudience := valid()
+	otherAudience["aud"] = "https://research.example.com/"
+	if verdict := v.Verify(sign(otherAudience), Expected{
+		Nonce:       expected.Nonce,
+		ImageDigest: expected.ImageDigest,
+		Audience:    "https://research.example.com/",
+	}); !verdict.Verified {
+		t.Errorf("expected a token of the job audience to be accepted, got %+v", verdict)
+	}
+
+	failures := map[string]jwt.MapClaims{
+		CheckIssuer:      {"iss": "https://evil.example.com"},
+		CheckAudience:    {"aud": "someone-else"},
+		CheckExpiry:      {"exp": time.Now().Add(-time.Minute).Unix()},
+		CheckNonce:       {"eat_nonce": "other"},
+		CheckImageDigest: {"submods": map[string]interface{}{"container": map[string]interface{}{"image_digest": "sha256:1234"}}},
+		CheckSecureBoot:  {"secboot": false},
+		CheckDebug:       {"dbgstat": "enabled"},
+	}
+	for check, override := range failures {
+		claims := valid()
+		for k, v := range override {
+			claims[k] = v
+		}
+		verdict := v.Verify(sign(claims), expected)
+		if verdict.Verified {
+			t.Errorf("%s: expected verification to fail", check)
+		}
+		for _, c := range verdict.Checks {
+			if c.Passed == (c.Name == check) {
+				t.Errorf("%s: unexpected result of check %+v", check, c)
+			}
+		}
+	}
+
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	forged, err := jwt.NewWithClaims(jwt.SigningMethodRS256, valid()).SignedString(otherKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, token := range map[string]string{
+		"forged":  forged,
+		"garbage": "mock tee token with nonce d41d8cd98f00b204e9800998ecf8427e",
+	} {
+		verdict := v.Verify(token, expected)
+		if verdict.Verified || len(verdict.Checks) != 1 || verdict.Checks[0].Name != CheckSignature {
+			t.Errorf("%s: expected only a failed signature check, got %+v", name, verdict)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package attestation
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+// ManifestVersion is the version of the manifest format.
+const ManifestVersion = 1
+
+// Manifest binds an attestation token to exactly one job, its input workspace and its output.
+// Its canonical form is the compact JSON encoding with the fields in declaration order,
+// and the nonce of the token is the SHA-256 digest of that form.
+type Manifest struct {
+	Version int    `json:"version"`
+	JobUUID string `json:"job_uuid"`
+	// WorkspaceSHA256 is the digest of the workspace archive the job was submitted with.
+	//
+	// It is not measured in the TEE: the API hashes the archive on submission and passes the digest to the image
+	// build as the WORKSPACE_HASH build arg, which the executor copies into the manifest. The TEE attests the digest of
+	// the image, which contains the workspace files, so a verifier trusts that the image built from the archive with
+	// this digest only as far as it trusts the API and the image builder of the deployment.
+	WorkspaceSHA256 string       `json:"workspace_sha256"`
+	Output          ManifestFile `json:"output"`
+}
+
+type ManifestFile struct {
+	Name   string `json:"name"`
+	SHA256 string `json:"sha256"`
+}
+
+// NewManifest creates the manifest of a job, hashing its output.
+func NewManifest(jobUUID string, workspaceSHA256 string, outputName string, output io.Reader) (*Manifest, error) {
+	hash := sha256.New()
+	if _, err := io.Copy(hash, output); err != nil {
+		return nil, errors.Wrap(err, "failed to hash output")
+	}
+	return &Manifest{
+		Version:         ManifestVersion,
+		JobUUID:         jobUUID,
+		WorkspaceSHA256: workspaceSHA256,
+		Output: ManifestFile{
+			Name:   outputName,
+			SHA256: hex.EncodeToString(hash.Sum(nil)),
+		},
+	}, nil
+}
+
+// Marshal returns the canonical form of the manifest.
+func (m *Manifest) Marshal() ([]byte, error) {
+	return json.Marshal(m)
+}
+
+// Nonce returns the hex encoded SHA-256 digest of the canonical form of the manifest.
+func (m *Manifest) Nonce() (string, error) {
+	data, err := m.Marshal()
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,42 @@
+package attestation
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+func TestManifest(t *testing.T) {
+	m, err := NewManifest("uuid-1", "abcd", "test.ipynb", strings.NewReader(""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := m.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"version":1,"job_uuid":"uuid-1","workspace_sha256":"abcd","output":{"name":"test.ipynb","sha256":"e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"}}`
+	if string(data) != expected {
+		t.Errorf("unexpected canonical manifest %s", data)
+	}
+	nonce, err := m.Nonce()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sum := sha256.Sum256([]byte(expected))
+	if nonce != hex.EncodeToString(sum[:]) {
+		t.Errorf("unexpected nonce %s", nonce)
+	}
+
+	// the nonce changes with any of the job, the workspace and the output
+	for _, other := range []*Manifest{
+		{Version: m.Version, JobUUID: "uuid-2", WorkspaceSHA256: m.WorkspaceSHA256, Output: m.Output},
+		{Version: m.Version, JobUUID: m.JobUUID, WorkspaceSHA256: "abce", Output: m.Output},
+		{Version: m.Version, JobUUID: m.JobUUID, WorkspaceSHA256: m.WorkspaceSHA256, Output: ManifestFile{Name: "test.ipynb", SHA256: "00"}},
+	} {
+		if otherNonce, _ := other.Nonce(); otherNonce == nonce {
+			t.Errorf("manifest %+v has the same nonce", other)
+		}
+	}
+}
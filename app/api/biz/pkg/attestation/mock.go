@@ -0,0 +1,153 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package attestation
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/manatee-project/manatee/app/api/biz/pkg/jwks"
+	"github.com/pkg/errors"
+)
+
+const (
+	// MockIssuer is the issuer of the tokens minted by mock TEEs.
+	MockIssuer = "https://manatee.local/mock-attestation"
+	// MockKeyID is the key id of the key mock TEEs sign their tokens with.
+	MockKeyID = "manatee-mock-attestation"
+	// mockTokenLifetime matches the lifetime of Confidential Space tokens.
+	mockTokenLifetime = time.Hour
+)
+
+// LoadMockKey reads the PEM encoded RSA private key mock TEEs sign their tokens with.
+func LoadMockKey(path string) (*rsa.PrivateKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read mock attestation key")
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data found in %s", path)
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse mock attestation key")
+	}
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("mock attestation key is a %T, not an RSA key", parsed)
+	}
+	return key, nil
+}
+
+// MockKeySet returns the JSON Web Key Set holding the public part of the mock key.
+func MockKeySet(key *rsa.PrivateKey) ([]byte, error) {
+	jwk, err := jwks.NewJSONWebKey(MockKeyID, &key.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	return jwks.Marshal(jwk)
+}
+
+// NewMockVerifier creates a verifier of the tokens signed with the mock key. The mock key is mounted in the workloads,
+// so such tokens prove nothing about the code that ran, and other verifiers reject them.
+func NewMockVerifier(key *rsa.PrivateKey, audience string) (*Verifier, error) {
+	data, err := MockKeySet(key)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := jwks.ParseKeySet(data)
+	if err != nil {
+		return nil, err
+	}
+	root, err := MockRootCertificate(key)
+	if err != nil {
+		return nil, err
+	}
+	roots := x509.NewCertPool()
+	roots.AddCert(root)
+	v := newVerifier(keys, roots, Config{Issuer: MockIssuer, Audience: audience})
+	v.mock = true
+	return v, nil
+}
+
+// MockRootCertificate returns the self-signed certificate of the mock key, which is the chain of mock PKI tokens.
+// It only depends on the key, so that the workloads and the API agree on it.
+func MockRootCertificate(key *rsa.PrivateKey) (*x509.Certificate, error) {
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Manatee Mock Attestation Root"},
+		NotBefore:             time.Unix(0, 0).UTC(),
+		NotAfter:              time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create mock root certificate")
+	}
+	return x509.ParseCertificate(der)
+}
+
+// SignMockToken mints a token shaped like the tokens of Confidential Space, for a workload
+// that runs the image with the given digest and is not debuggable. PKI tokens carry the mock root certificate.
+func SignMockToken(key *rsa.PrivateKey, tokenType string, audience string, nonces []string, imageDigest string, now time.Time) (string, error) {
+	claims := jwt.MapClaims{
+		"iss":       MockIssuer,
+		"sub":       "mock-tee",
+		"aud":       audience,
+		"iat":       now.Unix(),
+		"nbf":       now.Unix(),
+		"exp":       now.Add(mockTokenLifetime).Unix(),
+		"eat_nonce": nonces,
+		"hwmodel":   "MOCK",
+		"swname":    "CONFIDENTIAL_SPACE",
+		"swversion": []string{"mock"},
+		"secboot":   true,
+		"dbgstat":   debugDisabled,
+		"submods": map[string]interface{}{
+			"container": map[string]interface{}{
+				"image_digest": normalizeDigest(imageDigest),
+			},
+		},
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = MockKeyID
+	if tokenType == TokenTypePKI {
+		root, err := MockRootCertificate(key)
+		if err != nil {
+			return "", err
+		}
+		token.Header["x5c"] = []string{base64.StdEncoding.EncodeToString(root.Raw)}
+	}
+	signed, err := token.SignedString(key)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to sign mock token")
+	}
+	return signed, nil
+}
@@ -0,0 +1,85 @@
+package attestation
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMockToken(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyFile := filepath.Join(t.TempDir(), "key.pem")
+	data := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	if err := os.WriteFile(keyFile, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := LoadMockKey(keyFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, err := NewMockVerifier(loaded, "https://research.example.com/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	token, err := SignMockToken(loaded, TokenTypeOIDC, "https://research.example.com/", []string{"nonce-1", "nonce-2"}, testDigest, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	verdict := v.Verify(token, Expected{Nonce: "nonce-2", ImageDigest: testDigest})
+	if !verdict.Verified {
+		t.Errorf("expected the mock token to be verified, got %+v", verdict)
+	}
+	if verdict := v.Verify(token, Expected{Nonce: "nonce-3", ImageDigest: testDigest}); verdict.Verified {
+		t.Errorf("expected the mock token to fail with another nonce")
+	}
+
+	pkiToken, err := SignMockToken(loaded, TokenTypePKI, "https://research.example.com/", []string{"nonce-1"}, testDigest, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	verdict = v.Verify(pkiToken, Expected{Nonce: "nonce-1", ImageDigest: testDigest, TokenType: TokenTypePKI})
+	if !verdict.Verified || !strings.Contains(verdict.Checks[0].Detail, "Manatee Mock Attestation Root") {
+		t.Errorf("expected the mock PKI token to be verified with its chain, got %+v", verdict)
+	}
+
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	forged, err := SignMockToken(otherKey, TokenTypeOIDC, "https://research.example.com/", []string{"nonce-1"}, testDigest, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if verdict := v.Verify(forged, Expected{Nonce: "nonce-1", ImageDigest: testDigest}); verdict.Verified {
+		t.Errorf("expected a token signed with another key to fail")
+	}
+
+	// outside mock mode, mock tokens are rejected even if the mock key is trusted
+	keySet, err := MockKeySet(loaded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jwksFile := filepath.Join(t.TempDir(), "jwks.json")
+	if err := os.WriteFile(jwksFile, keySet, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	strict, err := NewVerifier(context.Background(), Config{Issuer: MockIssuer, JWKSFile: jwksFile})
+	if err != nil {
+		t.Fatal(err)
+	}
+	verdict = strict.Verify(token, Expected{Nonce: "nonce-1", ImageDigest: testDigest})
+	if verdict.Verified || !strings.Contains(verdict.Checks[1].Detail, "mock mode") {
+		t.Errorf("expected the mock token to be rejected outside mock mode, got %+v", verdict)
+	}
+}
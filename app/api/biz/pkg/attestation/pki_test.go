@@ -0,0 +1,121 @@
+package attestation
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+type testCert struct {
+	cert *x509.Certificate
+	key  *rsa.PrivateKey
+}
+
+func newTestCert(t *testing.T, name string, parent *testCert, ca bool, notAfter time.Time) *testCert {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              notAfter,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		IsCA:                  ca,
+		BasicConstraintsValid: true,
+	}
+	issuer, signer := template, key
+	if parent != nil {
+		issuer, signer = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, issuer, &key.PublicKey, signer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testCert{cert: cert, key: key}
+}
+
+func TestVerifyPKI(t *testing.T) {
+	root := newTestCert(t, "Test Root", nil, true, time.Now().Add(24*time.Hour))
+	intermediate := newTestCert(t, "Test Intermediate", root, true, time.Now().Add(24*time.Hour))
+	leaf := newTestCert(t, "Test Signer", intermediate, false, time.Now().Add(24*time.Hour))
+	rootFile := filepath.Join(t.TempDir(), "roots.pem")
+	if err := os.WriteFile(rootFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: root.cert.Raw}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	// no JWKS is fetched from the issuer when root certificates are configured
+	v, err := NewVerifier(context.Background(), Config{
+		Issuer:       "https://confidentialcomputing.googleapis.com",
+		Audience:     "https://research.tiktok.com/",
+		RootCertFile: rootFile,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sign := func(signer *rsa.PrivateKey, chain ...*testCert) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+			"iss":       "https://confidentialcomputing.googleapis.com",
+			"aud":       "https://research.tiktok.com/",
+			"exp":       time.Now().Add(time.Hour).Unix(),
+			"eat_nonce": "nonce",
+			"secboot":   true,
+			"dbgstat":   "disabled-since-boot",
+			"submods": map[string]interface{}{
+				"container": map[string]interface{}{"image_digest": "sha256:" + testDigest},
+			},
+		})
+		if chain != nil {
+			var x5c []string
+			for _, c := range chain {
+				x5c = append(x5c, base64.StdEncoding.EncodeToString(c.cert.Raw))
+			}
+			token.Header["x5c"] = x5c
+		}
+		s, err := token.SignedString(signer)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return s
+	}
+	expected := Expected{Nonce: "nonce", ImageDigest: testDigest, TokenType: TokenTypePKI}
+
+	if verdict := v.Verify(sign(leaf.key, leaf, intermediate, root), expected); !verdict.Verified {
+		t.Errorf("expected the PKI token to be verified, got %+v", verdict)
+	}
+	if verdict := v.Verify(sign(leaf.key, leaf, intermediate), expected); !verdict.Verified {
+		t.Errorf("expected the chain without the root to be verified, got %+v", verdict)
+	}
+
+	otherRoot := newTestCert(t, "Other Root", nil, true, time.Now().Add(24*time.Hour))
+	otherLeaf := newTestCert(t, "Other Signer", otherRoot, false, time.Now().Add(24*time.Hour))
+	expiredLeaf := newTestCert(t, "Expired Signer", intermediate, false, time.Now().Add(-time.Minute))
+	for name, token := range map[string]string{
+		"untrusted root":       sign(otherLeaf.key, otherLeaf, otherRoot),
+		"missing intermediate": sign(leaf.key, leaf),
+		"wrong signer":         sign(intermediate.key, leaf, intermediate),
+		"expired certificate":  sign(expiredLeaf.key, expiredLeaf, intermediate),
+		"no chain":             sign(leaf.key),
+	} {
+		verdict := v.Verify(token, expected)
+		if verdict.Verified || len(verdict.Checks) != 1 || verdict.Checks[0].Name != CheckSignature {
+			t.Errorf("%s: expected only a failed signature check, got %+v", name, verdict)
+		}
+	}
+}
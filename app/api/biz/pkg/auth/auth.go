@@ -0,0 +1,113 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/manatee-project/manatee/app/api/biz/pkg/config"
+	"github.com/manatee-project/manatee/app/api/biz/pkg/errno"
+)
+
+// Identity is the caller derived from the access token of a request.
+type Identity struct {
+	// Name is the user name jobs are created under. It is empty for anonymous callers.
+	Name   string
+	Groups []string
+}
+
+// Anonymous reports whether the identity was not derived from a verified token.
+func (i *Identity) Anonymous() bool {
+	return i.Name == ""
+}
+
+// InAnyGroup reports whether the identity belongs to one of groups.
+func (i *Identity) InAnyGroup(groups []string) bool {
+	for _, g := range i.Groups {
+		if slices.Contains(groups, g) {
+			return true
+		}
+	}
+	return false
+}
+
+// AuthorizeCreator checks that the caller is allowed to act on behalf of creator,
+// and returns the creator the request should use.
+// An empty creator defaults to the caller itself.
+// Anonymous identities are trusted with any creator, which is the legacy behavior when authentication is disabled.
+func (i *Identity) AuthorizeCreator(creator string) (string, error) {
+	if i.Anonymous() {
+		return creator, nil
+	}
+	if creator == "" {
+		return i.Name, nil
+	}
+	if creator != i.Name {
+		return "", errno.PermissionDeniedErr.WithMessage(fmt.Sprintf("%s cannot act on behalf of %s", i.Name, creator))
+	}
+	return creator, nil
+}
+
+type Authenticator interface {
+	// Authenticate verifies the access token and returns the identity it belongs to.
+	Authenticate(ctx context.Context, token string) (*Identity, error)
+}
+
+// NoneAuthenticator accepts every request as anonymous.
+type NoneAuthenticator struct {
+}
+
+func (n *NoneAuthenticator) Authenticate(ctx context.Context, token string) (*Identity, error) {
+	return &Identity{}, nil
+}
+
+// ParseAuthorizationHeader returns the token of an Authorization header value.
+// Both the bare token and the "Bearer <token>" form are accepted.
+func ParseAuthorizationHeader(header string) string {
+	header = strings.TrimSpace(header)
+	if len(header) > len("Bearer ") && strings.EqualFold(header[:len("Bearer ")], "Bearer ") {
+		return strings.TrimSpace(header[len("Bearer "):])
+	}
+	return header
+}
+
+func authenticationError(format string, args ...interface{}) error {
+	return errno.AuthenticationErr.WithMessage(fmt.Sprintf(format, args...))
+}
+
+// GetAuthenticator creates the authenticator selected by the auth configuration.
+func GetAuthenticator(ctx context.Context, cfg config.AuthConfig) (Authenticator, error) {
+	switch cfg.Type {
+	case "", "NONE":
+		return &NoneAuthenticator{}, nil
+	case "STATIC":
+		if cfg.StaticTokenFile == "" {
+			return nil, fmt.Errorf("static token file is not configured")
+		}
+		return NewStaticTokenAuthenticator(cfg.StaticTokenFile)
+	case "JWT":
+		return NewJWTAuthenticator(ctx, JWTConfig{
+			Issuer:        cfg.JWT.Issuer,
+			JWKSFile:      cfg.JWT.JWKSFile,
+			Audience:      cfg.JWT.Audience,
+			UsernameClaim: cfg.JWT.UsernameClaim,
+			GroupsClaim:   cfg.JWT.GroupsClaim,
+		})
+	}
+	return nil, fmt.Errorf("unknown auth type %s, supported are NONE, STATIC and JWT", cfg.Type)
+}
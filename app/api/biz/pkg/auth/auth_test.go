@@ -0,0 +1,180 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/manatee-project/manatee/app/api/biz/pkg/errno"
+	"github.com/manatee-project/manatee/app/api/biz/pkg/jwks"
+)
+
+func TestStaticTokenAuthenticator(t *testing.T) {
+	a, err := parseStaticTokens(strings.NewReader(`# token,user,groups
+token-alice,alice,"research,admin"
+
+token-bob,bob
+`))
+	if err != nil {
+		t.Fatalf("failed to parse tokens: %v", err)
+	}
+	identity, err := a.Authenticate(context.Background(), "token-alice")
+	if err != nil {
+		t.Fatalf("failed to authenticate: %v", err)
+	}
+	if identity.Name != "alice" || len(identity.Groups) != 2 || identity.Groups[1] != "admin" {
+		t.Errorf("unexpected identity %+v", identity)
+	}
+	identity, err = a.Authenticate(context.Background(), "token-bob")
+	if err != nil || identity.Name != "bob" || len(identity.Groups) != 0 {
+		t.Errorf("unexpected identity %+v, err %v", identity, err)
+	}
+	for _, token := range []string{"", "token-carol", "token-alic"} {
+		_, err = a.Authenticate(context.Background(), token)
+		if !isErrNo(err, errno.AuthenticationErrCode) {
+			t.Errorf("expected authentication error for %q, got %v", token, err)
+		}
+	}
+
+	_, err = parseStaticTokens(strings.NewReader("token-alice,alice\ntoken-alice,bob\n"))
+	if err == nil {
+		t.Errorf("expected error for duplicated token")
+	}
+	_, err = parseStaticTokens(strings.NewReader("token-alice\n"))
+	if err == nil {
+		t.Errorf("expected error for missing user")
+	}
+}
+
+func TestJWTAuthenticator(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jwk, err := jwks.NewJSONWebKey("test-key", &key.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := jwks.Marshal(jwk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys, err := jwks.ParseKeySet(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := newJWTAuthenticator(keys, JWTConfig{
+		Issuer:        "https://issuer.example.com",
+		Audience:      "manatee",
+		UsernameClaim: "preferred_username",
+	})
+
+	sign := func(claims jwt.MapClaims, kid string) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+		token.Header["kid"] = kid
+		s, err := token.SignedString(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return s
+	}
+	valid := func() jwt.MapClaims {
+		return jwt.MapClaims{
+			"iss":                "https://issuer.example.com",
+			"aud":                "manatee",
+			"sub":                "1234",
+			"preferred_username": "alice",
+			"groups":             []string{"research"},
+			"exp":                time.Now().Add(time.Hour).Unix(),
+		}
+	}
+
+	identity, err := a.Authenticate(context.Background(), sign(valid(), "test-key"))
+	if err != nil {
+		t.Fatalf("failed to authenticate: %v", err)
+	}
+	if identity.Name != "alice" || len(identity.Groups) != 1 || identity.Groups[0] != "research" {
+		t.Errorf("unexpected identity %+v", identity)
+	}
+
+	expired := valid()
+	expired["exp"] = time.Now().Add(-time.Hour).Unix()
+	noExpiry := valid()
+	delete(noExpiry, "exp")
+	wrongAudience := valid()
+	wrongAudience["aud"] = "someone-else"
+	wrongIssuer := valid()
+	wrongIssuer["iss"] = "https://evil.example.com"
+	noUsername := valid()
+	delete(noUsername, "preferred_username")
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	forged, err := jwt.NewWithClaims(jwt.SigningMethodRS256, valid()).SignedString(otherKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	unsigned, err := jwt.NewWithClaims(jwt.SigningMethodNone, valid()).SignedString(jwt.UnsafeAllowNoneSignatureType)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, token := range map[string]string{
+		"expired":        sign(expired, "test-key"),
+		"no expiry":      sign(noExpiry, "test-key"),
+		"wrong audience": sign(wrongAudience, "test-key"),
+		"wrong issuer":   sign(wrongIssuer, "test-key"),
+		"no username":    sign(noUsername, "test-key"),
+		"unknown kid":    sign(valid(), "other-key"),
+		"forged":         forged,
+		"unsigned":       unsigned,
+		"garbage":        "not-a-jwt",
+	} {
+		if _, err := a.Authenticate(context.Background(), token); !isErrNo(err, errno.AuthenticationErrCode) {
+			t.Errorf("%s: expected authentication error, got %v", name, err)
+		}
+	}
+}
+
+func TestAuthorizeCreator(t *testing.T) {
+	alice := &Identity{Name: "alice"}
+	if creator, err := alice.AuthorizeCreator("alice"); err != nil || creator != "alice" {
+		t.Errorf("alice should be allowed to act as alice, got %q, %v", creator, err)
+	}
+	if creator, err := alice.AuthorizeCreator(""); err != nil || creator != "alice" {
+		t.Errorf("empty creator should default to alice, got %q, %v", creator, err)
+	}
+	if _, err := alice.AuthorizeCreator("bob"); !isErrNo(err, errno.PermissionDeniedErrCode) {
+		t.Errorf("alice should not be allowed to act as bob, got %v", err)
+	}
+	anonymous := &Identity{}
+	if creator, err := anonymous.AuthorizeCreator("bob"); err != nil || creator != "bob" {
+		t.Errorf("anonymous identity should pass the creator through, got %q, %v", creator, err)
+	}
+}
+
+func TestParseAuthorizationHeader(t *testing.T) {
+	for header, expected := range map[string]string{
+		"":                "",
+		"abc":             "abc",
+		"Bearer abc":      "abc",
+		"bearer  abc ":    "abc",
+		"Bearer":          "Bearer",
+		"Basic dXNlcjpw=": "Basic dXNlcjpw=",
+	} {
+		if token := ParseAuthorizationHeader(header); token != expected {
+			t.Errorf("ParseAuthorizationHeader(%q) = %q, expected %q", header, token, expected)
+		}
+	}
+}
+
+func isErrNo(err error, code int32) bool {
+	var e errno.ErrNo
+	return errors.As(err, &e) && e.ErrCode == code
+}
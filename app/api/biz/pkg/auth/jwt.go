@@ -0,0 +1,109 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/manatee-project/manatee/app/api/biz/pkg/jwks"
+)
+
+type JWTConfig struct {
+	// Issuer is the expected iss claim. If JWKSFile is empty, the keys are discovered from the issuer.
+	Issuer string
+	// JWKSFile is a local JSON Web Key Set used to verify token signatures.
+	JWKSFile string
+	// Audience is the expected aud claim. The claim is not checked if empty.
+	Audience string
+	// UsernameClaim is the claim holding the user name, "sub" by default.
+	UsernameClaim string
+	// GroupsClaim is the claim holding the user groups, "groups" by default.
+	GroupsClaim string
+}
+
+// JWTAuthenticator authenticates requests carrying a JWT, such as an OIDC ID token.
+type JWTAuthenticator struct {
+	keys   *jwks.KeySet
+	config JWTConfig
+	parser *jwt.Parser
+}
+
+func NewJWTAuthenticator(ctx context.Context, config JWTConfig) (*JWTAuthenticator, error) {
+	var keys *jwks.KeySet
+	var err error
+	if config.JWKSFile != "" {
+		keys, err = jwks.LoadFile(config.JWKSFile)
+	} else if config.Issuer != "" {
+		keys, err = jwks.DiscoverKeySet(ctx, config.Issuer)
+	} else {
+		return nil, fmt.Errorf("either a jwks file or an issuer is required to verify tokens")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return newJWTAuthenticator(keys, config), nil
+}
+
+func newJWTAuthenticator(keys *jwks.KeySet, config JWTConfig) *JWTAuthenticator {
+	if config.UsernameClaim == "" {
+		config.UsernameClaim = "sub"
+	}
+	if config.GroupsClaim == "" {
+		config.GroupsClaim = "groups"
+	}
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}),
+		jwt.WithExpirationRequired(),
+	}
+	if config.Issuer != "" {
+		opts = append(opts, jwt.WithIssuer(config.Issuer))
+	}
+	if config.Audience != "" {
+		opts = append(opts, jwt.WithAudience(config.Audience))
+	}
+	return &JWTAuthenticator{
+		keys:   keys,
+		config: config,
+		parser: jwt.NewParser(opts...),
+	}
+}
+
+func (a *JWTAuthenticator) Authenticate(ctx context.Context, token string) (*Identity, error) {
+	if token == "" {
+		return nil, authenticationError("missing access token")
+	}
+	claims := jwt.MapClaims{}
+	if _, err := a.parser.ParseWithClaims(token, claims, a.keys.Keyfunc); err != nil {
+		return nil, authenticationError("invalid access token: %v", err)
+	}
+	name, ok := claims[a.config.UsernameClaim].(string)
+	if !ok || name == "" {
+		return nil, authenticationError("access token has no %s claim", a.config.UsernameClaim)
+	}
+	identity := &Identity{Name: name}
+	switch groups := claims[a.config.GroupsClaim].(type) {
+	case string:
+		identity.Groups = []string{groups}
+	case []interface{}:
+		for _, g := range groups {
+			if s, ok := g.(string); ok {
+				identity.Groups = append(identity.Groups, s)
+			}
+		}
+	}
+	return identity, nil
+}
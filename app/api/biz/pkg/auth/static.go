@@ -0,0 +1,92 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// StaticTokenAuthenticator authenticates requests against a fixed list of tokens.
+type StaticTokenAuthenticator struct {
+	// identities are indexed by the sha256 of the token, so that the lookup does not leak token prefixes through timing
+	identities map[[sha256.Size]byte]*Identity
+}
+
+// NewStaticTokenAuthenticator loads a token file in CSV format, one token per line:
+//
+//	token,user,"group1,group2"
+//
+// The groups column is optional. Empty lines and lines starting with # are ignored.
+func NewStaticTokenAuthenticator(path string) (*StaticTokenAuthenticator, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open static token file")
+	}
+	defer f.Close()
+	return parseStaticTokens(f)
+}
+
+func parseStaticTokens(r io.Reader) (*StaticTokenAuthenticator, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	identities := make(map[[sha256.Size]byte]*Identity)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse static token file")
+		}
+		line, _ := reader.FieldPos(0)
+		if len(record) < 2 || record[0] == "" || record[1] == "" {
+			return nil, fmt.Errorf("static token file line %d: expected token,user[,groups]", line)
+		}
+		identity := &Identity{Name: record[1]}
+		if len(record) > 2 && record[2] != "" {
+			for _, group := range strings.Split(record[2], ",") {
+				identity.Groups = append(identity.Groups, strings.TrimSpace(group))
+			}
+		}
+		key := sha256.Sum256([]byte(record[0]))
+		if _, ok := identities[key]; ok {
+			return nil, fmt.Errorf("static token file line %d: duplicated token", line)
+		}
+		identities[key] = identity
+	}
+	return &StaticTokenAuthenticator{identities: identities}, nil
+}
+
+func (s *StaticTokenAuthenticator) Authenticate(ctx context.Context, token string) (*Identity, error) {
+	if token == "" {
+		return nil, authenticationError("missing access token")
+	}
+	identity, ok := s.identities[sha256.Sum256([]byte(token))]
+	if !ok {
+		return nil, authenticationError("invalid access token")
+	}
+	return identity, nil
+}
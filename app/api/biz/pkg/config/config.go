@@ -0,0 +1,582 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// FileEnv is the environment variable holding the path of the YAML configuration file.
+const FileEnv = "MANATEE_CONFIG"
+
+// MaxJobTimeout is the longest expiry of GCS V4 signed URLs, which must outlive the job.
+const MaxJobTimeout = 7 * 24 * time.Hour
+
+// Config is the configuration shared by the api and the reconciler.
+// Every field can be set in the YAML file, and overridden by the environment variable in its env tag.
+type Config struct {
+	// Env names the deployment, and is part of the bucket and registry names.
+	Env       string `yaml:"env" env:"ENV"`
+	ProjectID string `yaml:"projectId" env:"PROJECT_ID"`
+	Region    string `yaml:"region" env:"REGION"`
+	Zone      string `yaml:"zone" env:"ZONE"`
+	Debug     bool   `yaml:"debug" env:"DEBUG"`
+	// TEEBackend is GCP or MOCK.
+	TEEBackend string `yaml:"teeBackend" env:"TEE_BACKEND"`
+	// StorageType is GCP, MINIO or MOCK.
+	StorageType string `yaml:"storageType" env:"STORAGE_TYPE"`
+	// RegistryType is GCP or MINIKUBE.
+	RegistryType string `yaml:"registryType" env:"REGISTRY_TYPE"`
+
+	MySQL MySQLConfig `yaml:"mysql"`
+	Minio MinioConfig `yaml:"minio"`
+	Auth  AuthConfig  `yaml:"auth"`
+	Job   JobConfig   `yaml:"job"`
+	// Resources are the machines jobs can run on.
+	Resources      ResourcesConfig      `yaml:"resources"`
+	LeaderElection LeaderElectionConfig `yaml:"leaderElection"`
+	Reconciler     ReconcilerConfig     `yaml:"reconciler"`
+	GC             GCConfig             `yaml:"gc"`
+	Quota          QuotaConfig          `yaml:"quota"`
+	Scheduler      SchedulerConfig      `yaml:"scheduler"`
+	Billing        BillingConfig        `yaml:"billing"`
+	Attestation    AttestationConfig    `yaml:"attestation"`
+}
+
+type MySQLConfig struct {
+	Host     string `yaml:"host" env:"MYSQL_HOST"`
+	Port     int    `yaml:"port" env:"MYSQL_PORT"`
+	Username string `yaml:"username" env:"MYSQL_USERNAME"`
+	Password string `yaml:"password" env:"MYSQL_PASSWORD"`
+	Database string `yaml:"database" env:"MYSQL_DATABASE"`
+}
+
+// DSN returns the data source name of the database.
+func (m MySQLConfig) DSN() string {
+	return fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?charset=utf8&parseTime=True&loc=Local", m.Username, m.Password, m.Host, m.Port, m.Database)
+}
+
+type MinioConfig struct {
+	Endpoint  string `yaml:"endpoint" env:"S3_ENDPOINT"`
+	AccessKey string `yaml:"accessKey" env:"AWS_ACCESS_KEY_ID"`
+	SecretKey string `yaml:"secretKey" env:"AWS_SECRET_ACCESS_KEY"`
+	Region    string `yaml:"region" env:"S3_REGION"`
+}
+
+type AuthConfig struct {
+	// Type is NONE, STATIC or JWT.
+	Type            string    `yaml:"type" env:"AUTH_TYPE"`
+	StaticTokenFile string    `yaml:"staticTokenFile" env:"AUTH_STATIC_TOKEN_FILE"`
+	JWT             JWTConfig `yaml:"jwt"`
+}
+
+type JWTConfig struct {
+	Issuer        string `yaml:"issuer" env:"AUTH_JWT_ISSUER"`
+	JWKSFile      string `yaml:"jwksFile" env:"AUTH_JWT_JWKS_FILE"`
+	Audience      string `yaml:"audience" env:"AUTH_JWT_AUDIENCE"`
+	UsernameClaim string `yaml:"usernameClaim" env:"AUTH_JWT_USERNAME_CLAIM"`
+	GroupsClaim   string `yaml:"groupsClaim" env:"AUTH_JWT_GROUPS_CLAIM"`
+}
+
+type JobConfig struct {
+	// DefaultTimeout applies to jobs submitted without a timeout.
+	DefaultTimeout time.Duration `yaml:"defaultTimeout" env:"JOB_DEFAULT_TIMEOUT"`
+	// MaxTimeout is the longest timeout a job can request.
+	MaxTimeout time.Duration `yaml:"maxTimeout" env:"JOB_MAX_TIMEOUT"`
+}
+
+// ConfidentialInstanceTypes are the confidential computing technologies a TEE instance can use.
+var ConfidentialInstanceTypes = []string{"TDX", "SEV", "SEV_SNP"}
+
+type ResourcesConfig struct {
+	// MachineType, DiskSizeGB and ConfidentialInstanceType apply to jobs that do not request them.
+	MachineType              string `yaml:"machineType" env:"TEE_MACHINE_TYPE"`
+	DiskSizeGB               int64  `yaml:"diskSizeGb" env:"TEE_DISK_SIZE_GB"`
+	ConfidentialInstanceType string `yaml:"confidentialInstanceType" env:"TEE_CONFIDENTIAL_INSTANCE_TYPE"`
+	// AllowedMachineTypes, MaxDiskSizeGB and AllowedConfidentialInstanceTypes limit what jobs can request.
+	AllowedMachineTypes              []string `yaml:"allowedMachineTypes" env:"TEE_ALLOWED_MACHINE_TYPES"`
+	MaxDiskSizeGB                    int64    `yaml:"maxDiskSizeGb" env:"TEE_MAX_DISK_SIZE_GB"`
+	AllowedConfidentialInstanceTypes []string `yaml:"allowedConfidentialInstanceTypes" env:"TEE_ALLOWED_CONFIDENTIAL_INSTANCE_TYPES"`
+}
+
+// Allows checks a resource profile against the allowlist.
+func (r ResourcesConfig) Allows(machineType string, diskSizeGB int64, confidentialInstanceType string) error {
+	if !slices.Contains(r.AllowedMachineTypes, machineType) {
+		return fmt.Errorf("machine type %q is not allowed, allowed are %s", machineType, strings.Join(r.AllowedMachineTypes, ", "))
+	}
+	if diskSizeGB <= 0 || diskSizeGB > r.MaxDiskSizeGB {
+		return fmt.Errorf("disk size %dGB is not allowed, the limit is %dGB", diskSizeGB, r.MaxDiskSizeGB)
+	}
+	if !slices.Contains(r.AllowedConfidentialInstanceTypes, confidentialInstanceType) {
+		return fmt.Errorf("confidential instance type %q is not allowed, allowed are %s", confidentialInstanceType, strings.Join(r.AllowedConfidentialInstanceTypes, ", "))
+	}
+	return nil
+}
+
+// LeaderElectionConfig lets several reconciler replicas run, with only the holder of a Kubernetes lease reconciling.
+type LeaderElectionConfig struct {
+	Enabled       bool          `yaml:"enabled" env:"LEADER_ELECTION_ENABLED"`
+	LeaseName     string        `yaml:"leaseName" env:"LEADER_ELECTION_LEASE_NAME"`
+	LeaseDuration time.Duration `yaml:"leaseDuration" env:"LEADER_ELECTION_LEASE_DURATION"`
+	RenewDeadline time.Duration `yaml:"renewDeadline" env:"LEADER_ELECTION_RENEW_DEADLINE"`
+	RetryPeriod   time.Duration `yaml:"retryPeriod" env:"LEADER_ELECTION_RETRY_PERIOD"`
+}
+
+// ReconcilerConfig bounds the concurrency of the reconciler.
+type ReconcilerConfig struct {
+	// Workers is the number of jobs reconciled concurrently.
+	Workers int `yaml:"workers" env:"RECONCILER_WORKERS"`
+	// JobDeadline bounds the time spent on one job in a reconcile pass, including the calls to the TEE backend.
+	JobDeadline time.Duration `yaml:"jobDeadline" env:"RECONCILER_JOB_DEADLINE"`
+	// ResyncPeriod is the interval of the passes over all jobs in progress, which catch up on the jobs
+	// whose changes are not watched, such as new jobs and GCP instances.
+	ResyncPeriod time.Duration `yaml:"resyncPeriod" env:"RECONCILER_RESYNC_PERIOD"`
+	// A job failing to reconcile is retried after RetryBaseDelay, doubled on every consecutive failure up to RetryMaxDelay.
+	RetryBaseDelay time.Duration `yaml:"retryBaseDelay" env:"RECONCILER_RETRY_BASE_DELAY"`
+	RetryMaxDelay  time.Duration `yaml:"retryMaxDelay" env:"RECONCILER_RETRY_MAX_DELAY"`
+	// MaxPermanentErrors is the number of errors that retrying cannot fix, such as a deleted instance, after which the job fails.
+	MaxPermanentErrors int `yaml:"maxPermanentErrors" env:"RECONCILER_MAX_PERMANENT_ERRORS"`
+}
+
+// GCConfig configures the deletion of TEE instances and image builds that no job in progress refers to.
+type GCConfig struct {
+	Enabled  bool          `yaml:"enabled" env:"GC_ENABLED"`
+	Interval time.Duration `yaml:"interval" env:"GC_INTERVAL"`
+	// GracePeriod is the minimum age of a deleted resource, so that resources created just before their job is written are kept.
+	GracePeriod time.Duration `yaml:"gracePeriod" env:"GC_GRACE_PERIOD"`
+	// DryRun only reports the resources that would be deleted.
+	DryRun bool `yaml:"dryRun" env:"GC_DRY_RUN"`
+}
+
+// QuotaConfig limits the jobs holding a VM or an image build at the same time. Submitted jobs are queued,
+// and the reconciler starts them in the order of the scheduler once they fit in every limit. A limit of 0 is unlimited.
+type QuotaConfig struct {
+	MaxJobsPerUser  int `yaml:"maxJobsPerUser" env:"QUOTA_MAX_JOBS_PER_USER"`
+	MaxJobsPerGroup int `yaml:"maxJobsPerGroup" env:"QUOTA_MAX_JOBS_PER_GROUP"`
+	// GroupLimits overrides MaxJobsPerGroup for the listed groups.
+	GroupLimits map[string]int `yaml:"groupLimits"`
+	MaxJobs     int            `yaml:"maxJobs" env:"QUOTA_MAX_JOBS"`
+	// MaxQueuedJobsPerUser is the number of waiting jobs a user can have, after which submissions are rejected.
+	MaxQueuedJobsPerUser int `yaml:"maxQueuedJobsPerUser" env:"QUOTA_MAX_QUEUED_JOBS_PER_USER"`
+}
+
+// GroupLimit returns the limit of group.
+func (q QuotaConfig) GroupLimit(group string) int {
+	if limit, ok := q.GroupLimits[group]; ok {
+		return limit
+	}
+	return q.MaxJobsPerGroup
+}
+
+// SchedulerConfig orders the queued jobs. Jobs with a higher priority start first, and among equal priorities
+// the jobs of the users who used the least VM time over FairShareWindow.
+type SchedulerConfig struct {
+	// DefaultPriority applies to jobs submitted without a priority.
+	DefaultPriority int `yaml:"defaultPriority" env:"SCHEDULER_DEFAULT_PRIORITY"`
+	// MaxPriority is the highest priority users can request, GroupMaxPriority raises it for the members of a group.
+	MaxPriority      int            `yaml:"maxPriority" env:"SCHEDULER_MAX_PRIORITY"`
+	GroupMaxPriority map[string]int `yaml:"groupMaxPriority"`
+	FairShareWindow  time.Duration  `yaml:"fairShareWindow" env:"SCHEDULER_FAIR_SHARE_WINDOW"`
+}
+
+// PriorityLimit returns the highest priority a member of groups can request.
+func (s SchedulerConfig) PriorityLimit(groups []string) int {
+	limit := s.MaxPriority
+	for _, g := range groups {
+		if p, ok := s.GroupMaxPriority[g]; ok && p > limit {
+			limit = p
+		}
+	}
+	return limit
+}
+
+// BillingConfig prices the VM time of the jobs reported by the usage API.
+type BillingConfig struct {
+	// HourlyPrices maps machine types to their price per hour, machine types without a price cost nothing.
+	HourlyPrices map[string]float64 `yaml:"hourlyPrices"`
+	Currency     string             `yaml:"currency" env:"BILLING_CURRENCY"`
+	// ViewerGroups can query the usage of all users, other users only their own.
+	ViewerGroups []string `yaml:"viewerGroups" env:"BILLING_VIEWER_GROUPS"`
+}
+
+// AttestationConfig verifies the attestation tokens of finished jobs.
+type AttestationConfig struct {
+	// Issuer is the expected iss claim. If JWKSFile is empty, the keys are discovered from the issuer.
+	Issuer   string `yaml:"issuer" env:"ATTESTATION_ISSUER"`
+	JWKSFile string `yaml:"jwksFile" env:"ATTESTATION_JWKS_FILE"`
+	// Audience is the audience jobs request their tokens for, recorded on each job at submission.
+	Audience string `yaml:"audience" env:"ATTESTATION_AUDIENCE"`
+	// TokenType is OIDC or PKI, the type of the tokens jobs request, recorded on each job at submission.
+	TokenType string `yaml:"tokenType" env:"ATTESTATION_TOKEN_TYPE"`
+	// RootCertFile is a PEM bundle of the roots PKI tokens are verified against. If set, the keys are not
+	// discovered from the issuer, and OIDC tokens are only verified with the JWKSFile.
+	RootCertFile string `yaml:"rootCertFile" env:"ATTESTATION_ROOT_CERT_FILE"`
+	// MockEnabled enables the mock mode of the MOCK TEE backend: its workloads sign their tokens with the mock key, and
+	// the API accepts such tokens instead of verifying them against the issuer. As the key is mounted in the workloads,
+	// any job can mint valid mock tokens, so mock mode must never be enabled where the attestations are relied on.
+	// Mock tokens are rejected unless it is enabled.
+	MockEnabled bool `yaml:"mockEnabled" env:"ATTESTATION_MOCK_ENABLED"`
+	// MockKeySecret is the Kubernetes secret holding the key.pem mock TEEs sign their tokens with in mock mode.
+	MockKeySecret string `yaml:"mockKeySecret" env:"ATTESTATION_MOCK_KEY_SECRET"`
+	// MockKeyFile is the same key mounted in the API, which then verifies mock tokens and serves the public key.
+	MockKeyFile string `yaml:"mockKeyFile" env:"ATTESTATION_MOCK_KEY_FILE"`
+}
+
+// Default returns the configuration used for the settings that are neither in the file nor in the environment.
+func Default() *Config {
+	return &Config{
+		TEEBackend:   "MOCK",
+		StorageType:  "MOCK",
+		RegistryType: "GCP",
+		MySQL: MySQLConfig{
+			Port: 3306,
+		},
+		Minio: MinioConfig{
+			Region: "us",
+		},
+		Auth: AuthConfig{
+			Type: "NONE",
+		},
+		Job: JobConfig{
+			DefaultTimeout: 6 * time.Hour,
+			MaxTimeout:     6 * time.Hour,
+		},
+		Resources: ResourcesConfig{
+			MachineType:                      "c3-standard-8",
+			DiskSizeGB:                       50,
+			ConfidentialInstanceType:         "TDX",
+			AllowedMachineTypes:              []string{"c3-standard-8"},
+			MaxDiskSizeGB:                    50,
+			AllowedConfidentialInstanceTypes: []string{"TDX"},
+		},
+		LeaderElection: LeaderElectionConfig{
+			LeaseName:     "manatee-reconciler",
+			LeaseDuration: 15 * time.Second,
+			RenewDeadline: 10 * time.Second,
+			RetryPeriod:   2 * time.Second,
+		},
+		Reconciler: ReconcilerConfig{
+			Workers:            4,
+			JobDeadline:        10 * time.Minute,
+			ResyncPeriod:       30 * time.Second,
+			RetryBaseDelay:     10 * time.Second,
+			RetryMaxDelay:      5 * time.Minute,
+			MaxPermanentErrors: 3,
+		},
+		GC: GCConfig{
+			Enabled:     true,
+			Interval:    10 * time.Minute,
+			GracePeriod: time.Hour,
+		},
+		Quota: QuotaConfig{
+			MaxJobsPerUser:       3,
+			MaxQueuedJobsPerUser: 20,
+		},
+		Scheduler: SchedulerConfig{
+			FairShareWindow: 7 * 24 * time.Hour,
+		},
+		Billing: BillingConfig{
+			Currency: "USD",
+		},
+		Attestation: AttestationConfig{
+			Issuer:        "https://confidentialcomputing.googleapis.com",
+			Audience:      "https://research.tiktok.com/",
+			TokenType:     "OIDC",
+			MockKeySecret: "manatee-mock-attestation",
+		},
+	}
+}
+
+// Load reads the configuration file at path, if any, on top of the defaults and applies the environment overrides.
+// The configuration is not validated.
+func Load(path string) (*Config, error) {
+	c := Default()
+	if path != "" {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open config file: %w", err)
+		}
+		defer f.Close()
+		if err := c.decode(f); err != nil {
+			return nil, fmt.Errorf("failed to parse config file %s: %w", path, err)
+		}
+	}
+	if err := applyEnv(reflect.ValueOf(c).Elem(), os.LookupEnv); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *Config) decode(r io.Reader) error {
+	decoder := yaml.NewDecoder(r)
+	decoder.KnownFields(true)
+	err := decoder.Decode(c)
+	if err == io.EOF {
+		// empty file
+		return nil
+	}
+	return err
+}
+
+var (
+	durationType    = reflect.TypeOf(time.Duration(0))
+	stringSliceType = reflect.TypeOf([]string(nil))
+)
+
+// applyEnv overrides the fields of v with the non-empty environment variables named in their env tags.
+func applyEnv(v reflect.Value, lookup func(string) (string, bool)) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := v.Field(i)
+		if field.Kind() == reflect.Struct {
+			if err := applyEnv(field, lookup); err != nil {
+				return err
+			}
+			continue
+		}
+		key := t.Field(i).Tag.Get("env")
+		if key == "" {
+			continue
+		}
+		value, ok := lookup(key)
+		if !ok || value == "" {
+			continue
+		}
+		switch {
+		case field.Type() == durationType:
+			d, err := time.ParseDuration(value)
+			if err != nil {
+				return fmt.Errorf("%s must be a duration, got %q", key, value)
+			}
+			field.SetInt(int64(d))
+		case field.Kind() == reflect.String:
+			field.SetString(value)
+		case field.Kind() == reflect.Bool:
+			b, err := strconv.ParseBool(value)
+			if err != nil {
+				return fmt.Errorf("%s must be a boolean, got %q", key, value)
+			}
+			field.SetBool(b)
+		case field.Kind() == reflect.Int || field.Kind() == reflect.Int64:
+			n, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return fmt.Errorf("%s must be an integer, got %q", key, value)
+			}
+			field.SetInt(n)
+		case field.Type() == stringSliceType:
+			// comma separated list
+			var items []string
+			for _, item := range strings.Split(value, ",") {
+				if item = strings.TrimSpace(item); item != "" {
+					items = append(items, item)
+				}
+			}
+			field.Set(reflect.ValueOf(items))
+		default:
+			return fmt.Errorf("unsupported type %s for %s", field.Type(), key)
+		}
+	}
+	return nil
+}
+
+// Validate checks that the settings required by the selected backends are present.
+// All problems are reported at once.
+func (c *Config) Validate() error {
+	var errs []error
+	require := func(value string, name string, reason string) {
+		if value == "" {
+			errs = append(errs, fmt.Errorf("%s is required %s", name, reason))
+		}
+	}
+
+	require(c.Env, "env", "to name the storage bucket and the registry")
+
+	switch c.TEEBackend {
+	case "GCP":
+		require(c.ProjectID, "projectId", "by the GCP TEE backend")
+		require(c.Region, "region", "by the GCP TEE backend")
+		require(c.Zone, "zone", "by the GCP TEE backend")
+	case "MOCK":
+	default:
+		errs = append(errs, fmt.Errorf("unknown teeBackend %q, supported are GCP and MOCK", c.TEEBackend))
+	}
+
+	switch c.StorageType {
+	case "MINIO":
+		require(c.Minio.Endpoint, "minio.endpoint", "by the MINIO storage")
+		require(c.Minio.AccessKey, "minio.accessKey", "by the MINIO storage")
+		require(c.Minio.SecretKey, "minio.secretKey", "by the MINIO storage")
+	case "GCP", "MOCK":
+	default:
+		errs = append(errs, fmt.Errorf("unknown storageType %q, supported are GCP, MINIO and MOCK", c.StorageType))
+	}
+
+	switch c.RegistryType {
+	case "GCP":
+		require(c.ProjectID, "projectId", "by the GCP registry")
+	case "MINIKUBE":
+	default:
+		errs = append(errs, fmt.Errorf("unknown registryType %q, supported are GCP and MINIKUBE", c.RegistryType))
+	}
+
+	require(c.MySQL.Host, "mysql.host", "to connect to the database")
+	require(c.MySQL.Username, "mysql.username", "to connect to the database")
+	require(c.MySQL.Database, "mysql.database", "to connect to the database")
+	if c.MySQL.Port <= 0 {
+		errs = append(errs, fmt.Errorf("mysql.port must be positive, got %d", c.MySQL.Port))
+	}
+
+	switch c.Auth.Type {
+	case "STATIC":
+		require(c.Auth.StaticTokenFile, "auth.staticTokenFile", "by STATIC authentication")
+	case "JWT":
+		if c.Auth.JWT.JWKSFile == "" && c.Auth.JWT.Issuer == "" {
+			errs = append(errs, fmt.Errorf("auth.jwt.jwksFile or auth.jwt.issuer is required by JWT authentication"))
+		}
+	case "NONE":
+	default:
+		errs = append(errs, fmt.Errorf("unknown auth.type %q, supported are NONE, STATIC and JWT", c.Auth.Type))
+	}
+
+	if c.Job.DefaultTimeout <= 0 || c.Job.MaxTimeout <= 0 {
+		errs = append(errs, fmt.Errorf("job.defaultTimeout and job.maxTimeout must be positive"))
+	} else if c.Job.MaxTimeout > MaxJobTimeout {
+		errs = append(errs, fmt.Errorf("job.maxTimeout %v exceeds the limit of %v", c.Job.MaxTimeout, MaxJobTimeout))
+	} else if c.Job.DefaultTimeout > c.Job.MaxTimeout {
+		errs = append(errs, fmt.Errorf("job.defaultTimeout %v exceeds job.maxTimeout %v", c.Job.DefaultTimeout, c.Job.MaxTimeout))
+	}
+
+	for _, t := range c.Resources.AllowedConfidentialInstanceTypes {
+		if !slices.Contains(ConfidentialInstanceTypes, t) {
+			errs = append(errs, fmt.Errorf("unknown confidential instance type %q, supported are %s", t, strings.Join(ConfidentialInstanceTypes, ", ")))
+		}
+	}
+	if err := c.Resources.Allows(c.Resources.MachineType, c.Resources.DiskSizeGB, c.Resources.ConfidentialInstanceType); err != nil {
+		errs = append(errs, fmt.Errorf("default resources: %w", err))
+	}
+
+	if c.Reconciler.Workers <= 0 || c.Reconciler.JobDeadline <= 0 || c.Reconciler.ResyncPeriod <= 0 {
+		errs = append(errs, fmt.Errorf("reconciler.workers, reconciler.jobDeadline and reconciler.resyncPeriod must be positive"))
+	}
+	if c.Reconciler.RetryBaseDelay <= 0 || c.Reconciler.RetryMaxDelay < c.Reconciler.RetryBaseDelay || c.Reconciler.MaxPermanentErrors <= 0 {
+		errs = append(errs, fmt.Errorf("reconciler retries must satisfy retryMaxDelay >= retryBaseDelay > 0 and maxPermanentErrors > 0"))
+	}
+
+	if c.GC.Enabled && (c.GC.Interval <= 0 || c.GC.GracePeriod <= 0) {
+		errs = append(errs, fmt.Errorf("gc.interval and gc.gracePeriod must be positive"))
+	}
+
+	q := c.Quota
+	if q.MaxJobsPerUser < 0 || q.MaxJobsPerGroup < 0 || q.MaxJobs < 0 || q.MaxQueuedJobsPerUser < 0 {
+		errs = append(errs, fmt.Errorf("quota limits must not be negative"))
+	}
+	for group, limit := range q.GroupLimits {
+		if limit < 0 {
+			errs = append(errs, fmt.Errorf("quota.groupLimits of %s must not be negative, got %d", group, limit))
+		}
+	}
+
+	s := c.Scheduler
+	if s.DefaultPriority < 0 || s.DefaultPriority > s.MaxPriority {
+		errs = append(errs, fmt.Errorf("scheduler.defaultPriority must be between 0 and scheduler.maxPriority %d, got %d", s.MaxPriority, s.DefaultPriority))
+	}
+	if s.FairShareWindow <= 0 {
+		errs = append(errs, fmt.Errorf("scheduler.fairShareWindow must be positive"))
+	}
+
+	for machineType, price := range c.Billing.HourlyPrices {
+		if price < 0 {
+			errs = append(errs, fmt.Errorf("billing.hourlyPrices of %s must not be negative, got %v", machineType, price))
+		}
+	}
+
+	if c.Attestation.JWKSFile == "" && c.Attestation.Issuer == "" && c.Attestation.RootCertFile == "" {
+		errs = append(errs, fmt.Errorf("attestation.jwksFile, attestation.issuer or attestation.rootCertFile is required to verify attestation tokens"))
+	}
+	switch c.Attestation.TokenType {
+	case "OIDC":
+	case "PKI":
+		if c.Attestation.RootCertFile == "" && !c.Attestation.MockEnabled {
+			errs = append(errs, fmt.Errorf("attestation.rootCertFile is required to verify PKI tokens"))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("unknown attestation.tokenType %q, supported are OIDC and PKI", c.Attestation.TokenType))
+	}
+	require(c.Attestation.Audience, "attestation.audience", "to request attestation tokens")
+	if c.Attestation.MockEnabled {
+		if c.TEEBackend != "MOCK" {
+			errs = append(errs, fmt.Errorf("attestation.mockEnabled requires the MOCK teeBackend, got %q", c.TEEBackend))
+		}
+		require(c.Attestation.MockKeyFile, "attestation.mockKeyFile", "by attestation.mockEnabled")
+	}
+
+	if c.LeaderElection.Enabled {
+		l := c.LeaderElection
+		require(l.LeaseName, "leaderElection.leaseName", "by leader election")
+		if l.RetryPeriod <= 0 || l.RenewDeadline <= l.RetryPeriod || l.LeaseDuration <= l.RenewDeadline {
+			errs = append(errs, fmt.Errorf("leaderElection durations must satisfy leaseDuration > renewDeadline > retryPeriod > 0"))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid configuration: %w", errors.Join(errs...))
+	}
+	return nil
+}
+
+var (
+	current *Config
+	loadErr error
+	once    sync.Once
+)
+
+func load() {
+	once.Do(func() {
+		current, loadErr = Load(os.Getenv(FileEnv))
+	})
+}
+
+// Init loads the configuration from the file in MANATEE_CONFIG and the environment,
+// and panics if it is invalid. It is meant to be called once at startup.
+func Init() {
+	load()
+	if loadErr != nil {
+		panic(loadErr)
+	}
+	if err := current.Validate(); err != nil {
+		panic(err)
+	}
+}
+
+// Get returns the process configuration.
+// It is loaded on first use, without validation, if Init has not been called.
+func Get() *Config {
+	load()
+	if loadErr != nil {
+		panic(loadErr)
+	}
+	return current
+}
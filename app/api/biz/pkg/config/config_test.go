@@ -0,0 +1,137 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testConfig = `
+env: dev
+projectId: my-project
+region: us-west1
+zone: us-west1-b
+teeBackend: GCP
+storageType: MINIO
+minio:
+  endpoint: minio:9000
+  accessKey: key
+  secretKey: secret
+mysql:
+  host: localhost
+  port: 9910
+  username: manatee
+  database: manatee
+job:
+  defaultTimeout: 2h
+  maxTimeout: 24h
+`
+
+func writeConfig(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoad(t *testing.T) {
+	t.Setenv("MYSQL_PASSWORD", "password")
+	t.Setenv("JOB_MAX_TIMEOUT", "48h")
+	t.Setenv("DEBUG", "true")
+	t.Setenv("ZONE", "")
+	t.Setenv("TEE_ALLOWED_MACHINE_TYPES", "c3-standard-8, n2d-standard-8")
+
+	c, err := Load(writeConfig(t, testConfig))
+	if err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+	if err := c.Validate(); err != nil {
+		t.Errorf("expected valid config, got %v", err)
+	}
+	if c.Env != "dev" || c.Zone != "us-west1-b" || c.Minio.Endpoint != "minio:9000" || c.MySQL.Port != 9910 {
+		t.Errorf("unexpected config %+v", c)
+	}
+	if c.MySQL.Password != "password" || c.Job.MaxTimeout != 48*time.Hour || !c.Debug {
+		t.Errorf("environment overrides are not applied: %+v", c)
+	}
+	if len(c.Resources.AllowedMachineTypes) != 2 || c.Resources.AllowedMachineTypes[1] != "n2d-standard-8" {
+		t.Errorf("unexpected allowed machine types %v", c.Resources.AllowedMachineTypes)
+	}
+	if c.Job.DefaultTimeout != 2*time.Hour || c.RegistryType != "GCP" || c.Auth.Type != "NONE" {
+		t.Errorf("defaults are not applied: %+v", c)
+	}
+
+	if _, err := Load(writeConfig(t, "teeBackend: GCP\nzones: us-west1-b\n")); err == nil {
+		t.Errorf("expected error for unknown field")
+	}
+	t.Setenv("MYSQL_PORT", "port")
+	if _, err := Load(writeConfig(t, testConfig)); err == nil {
+		t.Errorf("expected error for invalid MYSQL_PORT")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	valid := func() *Config {
+		c := Default()
+		c.Env = "dev"
+		c.ProjectID = "my-project"
+		c.MySQL.Host = "localhost"
+		c.MySQL.Username = "manatee"
+		c.MySQL.Database = "manatee"
+		return c
+	}
+	if err := valid().Validate(); err != nil {
+		t.Fatalf("expected valid config, got %v", err)
+	}
+
+	for name, tc := range map[string]struct {
+		modify   func(c *Config)
+		expected string
+	}{
+		"missing region": {func(c *Config) { c.TEEBackend = "GCP"; c.Zone = "us-west1-b" }, "region"},
+		"missing zone":   {func(c *Config) { c.TEEBackend = "GCP"; c.Region = "us-west1" }, "zone"},
+		"missing project": {func(c *Config) {
+			c.ProjectID = ""
+			c.RegistryType = "GCP"
+		}, "projectId"},
+		"unknown tee backend": {func(c *Config) { c.TEEBackend = "AWS" }, "teeBackend"},
+		"missing minio":       {func(c *Config) { c.StorageType = "MINIO" }, "minio.endpoint"},
+		"missing token file":  {func(c *Config) { c.Auth.Type = "STATIC" }, "auth.staticTokenFile"},
+		"default above max":   {func(c *Config) { c.Job.DefaultTimeout = 12 * time.Hour }, "job.defaultTimeout"},
+		"max above limit":     {func(c *Config) { c.Job.MaxTimeout = 8 * 24 * time.Hour }, "job.maxTimeout"},
+		"leader election durations": {func(c *Config) {
+			c.LeaderElection.Enabled = true
+			c.LeaderElection.RenewDeadline = c.LeaderElection.LeaseDuration
+		}, "leaderElection"},
+		"no reconciler workers":   {func(c *Config) { c.Reconciler.Workers = 0 }, "reconciler.workers"},
+		"negative quota":          {func(c *Config) { c.Quota.MaxJobs = -1 }, "quota limits"},
+		"negative price":          {func(c *Config) { c.Billing.HourlyPrices = map[string]float64{"c3-standard-8": -1} }, "billing.hourlyPrices of c3-standard-8"},
+		"no attestation keys":     {func(c *Config) { c.Attestation.Issuer = "" }, "attestation.jwksFile, attestation.issuer or attestation.rootCertFile"},
+		"pki without roots":       {func(c *Config) { c.Attestation.TokenType = "PKI" }, "attestation.rootCertFile"},
+		"unknown token type":      {func(c *Config) { c.Attestation.TokenType = "JWT" }, "attestation.tokenType"},
+		"no attestation audience": {func(c *Config) { c.Attestation.Audience = "" }, "attestation.audience"},
+		"mock mode without key":   {func(c *Config) { c.Attestation.MockEnabled = true }, "attestation.mockKeyFile"},
+		"mock mode on gcp": {func(c *Config) {
+			c.TEEBackend = "GCP"
+			c.Zone = "us-west1-b"
+			c.Attestation.MockEnabled = true
+			c.Attestation.MockKeyFile = "/var/run/manatee/mock-attestation/key.pem"
+		}, "attestation.mockEnabled"},
+		"default priority above max": {func(c *Config) { c.Scheduler.DefaultPriority = 1 }, "scheduler.defaultPriority"},
+		"negative group limit":       {func(c *Config) { c.Quota.GroupLimits = map[string]int{"team": -1} }, "quota.groupLimits of team"},
+		"default not allowed":        {func(c *Config) { c.Resources.MachineType = "n2d-standard-8" }, "default resources"},
+		"unknown confidential type": {func(c *Config) {
+			c.Resources.AllowedConfidentialInstanceTypes = append(c.Resources.AllowedConfidentialInstanceTypes, "SGX")
+		}, "SGX"},
+	} {
+		c := valid()
+		tc.modify(c)
+		err := c.Validate()
+		if err == nil || !strings.Contains(err.Error(), tc.expected) {
+			t.Errorf("%s: expected error about %s, got %v", name, tc.expected, err)
+		}
+	}
+}
@@ -22,12 +22,28 @@ const (
 	SuccessCode    = 0
 	ServiceErrCode = iota + 10000
 	ReachJobLimitErrCode
+	AuthenticationErrCode
+	PermissionDeniedErrCode
+	JobNotCancellableErrCode
+	JobTimeoutExceedsLimitErrCode
+	ResourceNotAllowedErrCode
+	PriorityNotAllowedErrCode
+	UsageRangeInvalidErrCode
+	AttestationUnavailableErrCode
 )
 
 const (
-	SuccessMsg          = "Success"
-	ServiceErrMsg       = "Service internal error"
-	ReachJobLimitErrMsg = "The number of in progress jobs has reached the limit"
+	SuccessMsg                   = "Success"
+	ServiceErrMsg                = "Service internal error"
+	ReachJobLimitErrMsg          = "The number of queued jobs has reached the limit"
+	AuthenticationErrMsg         = "Authentication failed"
+	PermissionDeniedErrMsg       = "Permission denied"
+	JobNotCancellableErrMsg      = "The job has already finished"
+	JobTimeoutExceedsLimitErrMsg = "The job timeout exceeds the limit"
+	ResourceNotAllowedErrMsg     = "The requested resources are not allowed"
+	PriorityNotAllowedErrMsg     = "The requested priority is not allowed"
+	UsageRangeInvalidErrMsg      = "The usage date range is invalid"
+	AttestationUnavailableErrMsg = "The attestation token of the job is not available"
 )
 
 type ErrNo struct {
@@ -49,7 +65,15 @@ func (e ErrNo) WithMessage(msg string) ErrNo {
 }
 
 var (
-	Success          = NewErrNo(SuccessCode, SuccessMsg)
-	ServiceErr       = NewErrNo(ServiceErrCode, ServiceErrMsg)
-	ReachJobLimitErr = NewErrNo(ReachJobLimitErrCode, ReachJobLimitErrMsg)
+	Success                   = NewErrNo(SuccessCode, SuccessMsg)
+	ServiceErr                = NewErrNo(ServiceErrCode, ServiceErrMsg)
+	ReachJobLimitErr          = NewErrNo(ReachJobLimitErrCode, ReachJobLimitErrMsg)
+	AuthenticationErr         = NewErrNo(AuthenticationErrCode, AuthenticationErrMsg)
+	PermissionDeniedErr       = NewErrNo(PermissionDeniedErrCode, PermissionDeniedErrMsg)
+	JobNotCancellableErr      = NewErrNo(JobNotCancellableErrCode, JobNotCancellableErrMsg)
+	JobTimeoutExceedsLimitErr = NewErrNo(JobTimeoutExceedsLimitErrCode, JobTimeoutExceedsLimitErrMsg)
+	ResourceNotAllowedErr     = NewErrNo(ResourceNotAllowedErrCode, ResourceNotAllowedErrMsg)
+	PriorityNotAllowedErr     = NewErrNo(PriorityNotAllowedErrCode, PriorityNotAllowedErrMsg)
+	UsageRangeInvalidErr      = NewErrNo(UsageRangeInvalidErrCode, UsageRangeInvalidErrMsg)
+	AttestationUnavailableErr = NewErrNo(AttestationUnavailableErrCode, AttestationUnavailableErrMsg)
 )
@@ -0,0 +1,303 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jwks
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+)
+
+// minRefreshInterval rate limits refetching a remote key set when a token refers to an unknown key id.
+// Failed fetches count, so that an unreachable issuer is not retried on every token.
+const minRefreshInterval = time.Minute
+
+// JSONWebKey is a single entry of a JSON Web Key Set (RFC 7517).
+// Only the members needed to verify RSA and EC signatures are supported.
+type JSONWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid,omitempty"`
+	Use string `json:"use,omitempty"`
+	Alg string `json:"alg,omitempty"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+type jsonWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+// KeySet holds the public keys of a JSON Web Key Set, indexed by key id.
+// A KeySet created from a URL refreshes itself when it sees an unknown key id.
+type KeySet struct {
+	mu     sync.RWMutex
+	keys   map[string]crypto.PublicKey
+	url    string
+	client *http.Client
+	// refreshMu makes the refreshes single-flight: concurrent lookups of an unknown key id wait for the same fetch.
+	refreshMu   sync.Mutex
+	lastRefresh time.Time // guarded by refreshMu
+}
+
+// ParseKeySet parses a JSON Web Key Set document.
+func ParseKeySet(data []byte) (*KeySet, error) {
+	keys, err := parseKeys(data)
+	if err != nil {
+		return nil, err
+	}
+	return &KeySet{keys: keys}, nil
+}
+
+// LoadFile reads a JSON Web Key Set from a local file.
+func LoadFile(path string) (*KeySet, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read jwks file")
+	}
+	return ParseKeySet(data)
+}
+
+// NewRemoteKeySet fetches a JSON Web Key Set from url.
+func NewRemoteKeySet(ctx context.Context, url string) (*KeySet, error) {
+	k := &KeySet{
+		url:    url,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+	if err := k.refreshIfStale(ctx); err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+// DiscoverKeySet looks up the jwks_uri of an OpenID Connect issuer and fetches its key set.
+func DiscoverKeySet(ctx context.Context, issuer string) (*KeySet, error) {
+	client := &http.Client{Timeout: 10 * time.Second}
+	discoveryURL := strings.TrimSuffix(issuer, "/") + "/.well-known/openid-configuration"
+	body, err := httpGet(ctx, client, discoveryURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch openid configuration")
+	}
+	var discovery struct {
+		JwksURI string `json:"jwks_uri"`
+	}
+	if err := json.Unmarshal(body, &discovery); err != nil {
+		return nil, errors.Wrap(err, "failed to parse openid configuration")
+	}
+	if discovery.JwksURI == "" {
+		return nil, fmt.Errorf("openid configuration of %s has no jwks_uri", issuer)
+	}
+	return NewRemoteKeySet(ctx, discovery.JwksURI)
+}
+
+// Key returns the public key with the given key id. An empty kid matches
+// the only key of a single-key set.
+func (k *KeySet) Key(kid string) (crypto.PublicKey, error) {
+	if key, ok := k.lookup(kid); ok {
+		return key, nil
+	}
+	if k.url != "" {
+		if err := k.refreshIfStale(context.Background()); err != nil {
+			return nil, err
+		}
+		if key, ok := k.lookup(kid); ok {
+			return key, nil
+		}
+	}
+	return nil, fmt.Errorf("no key found for kid %q", kid)
+}
+
+// Keyfunc is a jwt.Keyfunc resolving the verification key from the kid header of the token.
+func (k *KeySet) Keyfunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	return k.Key(kid)
+}
+
+func (k *KeySet) lookup(kid string) (crypto.PublicKey, bool) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	if kid == "" && len(k.keys) == 1 {
+		for _, key := range k.keys {
+			return key, true
+		}
+	}
+	key, ok := k.keys[kid]
+	return key, ok
+}
+
+// refreshIfStale refetches the key set unless it was fetched within minRefreshInterval. The callers that wait for
+// a fetch in progress then find the keys it fetched, without fetching again.
+func (k *KeySet) refreshIfStale(ctx context.Context) error {
+	k.refreshMu.Lock()
+	defer k.refreshMu.Unlock()
+	if !k.lastRefresh.IsZero() && time.Since(k.lastRefresh) < minRefreshInterval {
+		return nil
+	}
+	k.lastRefresh = time.Now()
+	return k.refresh(ctx)
+}
+
+func (k *KeySet) refresh(ctx context.Context) error {
+	body, err := httpGet(ctx, k.client, k.url)
+	if err != nil {
+		return errors.Wrap(err, "failed to fetch jwks")
+	}
+	keys, err := parseKeys(body)
+	if err != nil {
+		return err
+	}
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.keys = keys
+	return nil
+}
+
+func httpGet(ctx context.Context, client *http.Client, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d from %s", resp.StatusCode, url)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+func parseKeys(data []byte) (map[string]crypto.PublicKey, error) {
+	var set jsonWebKeySet
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, errors.Wrap(err, "failed to parse jwks")
+	}
+	keys := make(map[string]crypto.PublicKey)
+	for _, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.PublicKey()
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse key %q", jwk.Kid)
+		}
+		keys[jwk.Kid] = key
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("jwks does not contain any signing key")
+	}
+	return keys, nil
+}
+
+// PublicKey decodes the RSA or EC public key held by the JWK.
+func (j *JSONWebKey) PublicKey() (crypto.PublicKey, error) {
+	switch j.Kty {
+	case "RSA":
+		n, err := decodeBigInt(j.N)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid modulus")
+		}
+		e, err := decodeBigInt(j.E)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid exponent")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch j.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", j.Crv)
+		}
+		x, err := decodeBigInt(j.X)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid x coordinate")
+		}
+		y, err := decodeBigInt(j.Y)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid y coordinate")
+		}
+		if !curve.IsOnCurve(x, y) {
+			return nil, fmt.Errorf("point is not on curve %s", j.Crv)
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	}
+	return nil, fmt.Errorf("unsupported key type %q", j.Kty)
+}
+
+// NewJSONWebKey encodes an RSA or EC public key as a JWK with the given key id.
+func NewJSONWebKey(kid string, key crypto.PublicKey) (*JSONWebKey, error) {
+	switch k := key.(type) {
+	case *rsa.PublicKey:
+		return &JSONWebKey{
+			Kty: "RSA",
+			Kid: kid,
+			Use: "sig",
+			N:   base64.RawURLEncoding.EncodeToString(k.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(k.E)).Bytes()),
+		}, nil
+	case *ecdsa.PublicKey:
+		size := (k.Curve.Params().BitSize + 7) / 8
+		return &JSONWebKey{
+			Kty: "EC",
+			Kid: kid,
+			Use: "sig",
+			Crv: k.Curve.Params().Name,
+			X:   base64.RawURLEncoding.EncodeToString(k.X.FillBytes(make([]byte, size))),
+			Y:   base64.RawURLEncoding.EncodeToString(k.Y.FillBytes(make([]byte, size))),
+		}, nil
+	}
+	return nil, fmt.Errorf("unsupported public key type %T", key)
+}
+
+// Marshal encodes the given keys as a JSON Web Key Set document.
+func Marshal(keys ...*JSONWebKey) ([]byte, error) {
+	set := jsonWebKeySet{Keys: []JSONWebKey{}}
+	for _, k := range keys {
+		set.Keys = append(set.Keys, *k)
+	}
+	return json.Marshal(set)
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
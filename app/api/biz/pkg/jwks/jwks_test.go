@@ -0,0 +1,72 @@
+package jwks
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRemoteKeySetRefresh(t *testing.T) {
+	var kid atomic.Value
+	kid.Store("key-1")
+	var fetches atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches.Add(1)
+		// the fetch lasts long enough for the lookups to overlap
+		time.Sleep(50 * time.Millisecond)
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			t.Error(err)
+		}
+		jwk, err := NewJSONWebKey(kid.Load().(string), &key.PublicKey)
+		if err != nil {
+			t.Error(err)
+		}
+		data, err := Marshal(jwk)
+		if err != nil {
+			t.Error(err)
+		}
+		w.Write(data)
+	}))
+	defer server.Close()
+
+	k, err := NewRemoteKeySet(context.Background(), server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := k.Key("key-1"); err != nil || fetches.Load() != 1 {
+		t.Errorf("expected key-1 after a single fetch, got %v after %d fetches", err, fetches.Load())
+	}
+
+	// an unknown key id within the minimum refresh interval does not refetch
+	if _, err := k.Key("key-2"); err == nil || fetches.Load() != 1 {
+		t.Errorf("expected no key-2 without a fetch, got %v after %d fetches", err, fetches.Load())
+	}
+
+	// once the keys rotated, concurrent lookups of the new key id wait for the same fetch
+	kid.Store("key-2")
+	k.refreshMu.Lock()
+	k.lastRefresh = time.Now().Add(-2 * minRefreshInterval)
+	k.refreshMu.Unlock()
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := k.Key("key-2"); err != nil {
+				t.Errorf("expected key-2 after the refresh, got %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+	if fetches.Load() != 2 {
+		t.Errorf("expected a single refresh, got %d fetches", fetches.Load())
+	}
+}
@@ -70,6 +70,14 @@ func (g *GoogleCloudStorage) UploadFile(reader io.Reader, remotePath string, com
 	return nil
 }
 
+func (g *GoogleCloudStorage) DownloadFile(remotePath string) (io.ReadCloser, error) {
+	reader, err := g.client.Bucket(g.bucket).Object(remotePath).NewReader(g.ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create object reader")
+	}
+	return reader, nil
+}
+
 func (g *GoogleCloudStorage) IssueSignedUrl(remotePath string, method string, expires time.Duration) (string, error) {
 	if method != "GET" && method != "PUT" {
 		return "", errors.Wrap(fmt.Errorf("unkown method for signed url, supported are GET and PUT"), "")
@@ -5,9 +5,9 @@ import (
 	"fmt"
 	"io"
 	"net/url"
-	"os"
 	"time"
 
+	"github.com/manatee-project/manatee/app/api/biz/pkg/config"
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/credentials"
 	"github.com/pkg/errors"
@@ -19,21 +19,12 @@ type MinioStorage struct {
 	minioClient minio.Client
 }
 
-func NewMinioStorage(ctx context.Context, bucket string) (*MinioStorage, error) {
-	accessKeyID := os.Getenv("AWS_ACCESS_KEY_ID")
-	if accessKeyID == "" {
-		return nil, fmt.Errorf("AWS_ACCESS_KEY_ID environment variable is not present")
+func NewMinioStorage(ctx context.Context, bucket string, cfg config.MinioConfig) (*MinioStorage, error) {
+	if cfg.Endpoint == "" || cfg.AccessKey == "" || cfg.SecretKey == "" {
+		return nil, fmt.Errorf("minio endpoint and credentials are not configured")
 	}
-	secretAccessKey := os.Getenv("AWS_SECRET_ACCESS_KEY")
-	if secretAccessKey == "" {
-		return nil, fmt.Errorf("AWS_SECRET_ACCESS_KEY environment variable is not present")
-	}
-	endpoint := os.Getenv("S3_ENDPOINT")
-	if endpoint == "" {
-		return nil, fmt.Errorf("S3_ENDPOINT environment variable is not present")
-	}
-	minioClient, err := minio.New(endpoint, &minio.Options{
-		Creds:  credentials.NewStaticV4(accessKeyID, secretAccessKey, ""),
+	minioClient, err := minio.New(cfg.Endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(cfg.AccessKey, cfg.SecretKey, ""),
 		Secure: false,
 	})
 	if err != nil {
@@ -46,7 +37,7 @@ func NewMinioStorage(ctx context.Context, bucket string) (*MinioStorage, error)
 	}
 
 	if !exist {
-		err = minioClient.MakeBucket(ctx, bucket, minio.MakeBucketOptions{Region: "us"})
+		err = minioClient.MakeBucket(ctx, bucket, minio.MakeBucketOptions{Region: cfg.Region})
 		if err != nil {
 			return nil, err
 		}
@@ -75,6 +66,14 @@ func (m *MinioStorage) UploadFile(reader io.Reader, remotePath string, compress
 	return nil
 }
 
+func (m *MinioStorage) DownloadFile(remotePath string) (io.ReadCloser, error) {
+	object, err := m.minioClient.GetObject(m.ctx, m.bucket, remotePath, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to download from minio")
+	}
+	return object, nil
+}
+
 func (m *MinioStorage) IssueSignedUrl(remotePath string, method string, expires time.Duration) (string, error) {
 	reqParams := make(url.Values)
 	var url *url.URL
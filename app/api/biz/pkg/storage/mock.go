@@ -3,6 +3,7 @@ package storage
 import (
 	"context"
 	"io"
+	"strings"
 	"time"
 )
 
@@ -28,6 +29,10 @@ func (m *MockStorage) UploadFile(reader io.Reader, remotePath string, compress b
 	return nil
 }
 
+func (m *MockStorage) DownloadFile(remotePath string) (io.ReadCloser, error) {
+	return io.NopCloser(strings.NewReader("")), nil
+}
+
 func (m *MockStorage) IssueSignedUrl(remotePath string, method string, expires time.Duration) (string, error) {
 	return "", nil
 }
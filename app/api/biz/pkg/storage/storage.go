@@ -4,43 +4,58 @@ import (
 	"context"
 	"fmt"
 	"io"
-	"os"
 	"time"
 
+	"github.com/manatee-project/manatee/app/api/biz/pkg/config"
 	"github.com/pkg/errors"
 )
 
 type Storage interface {
 	BucketPath() string
 	UploadFile(reader io.Reader, remotePath string, compress bool) error
+	DownloadFile(remotePath string) (io.ReadCloser, error)
 	IssueSignedUrl(remotePath string, method string, expiry time.Duration) (string, error)
 	Close()
 }
 
-func getBucket() (string, error) {
-	env := os.Getenv("ENV")
+// JobOutputPath returns the path of the output of a job, uploaded by the job with a signed URL.
+func JobOutputPath(creator string, uuid string, notebook string) string {
+	return fmt.Sprintf("%s/output/out-%s-%s", creator, uuid, notebook)
+}
+
+// JobTokenPath returns the path of the attestation token of a job, uploaded by the job with a signed URL.
+func JobTokenPath(creator string, uuid string) string {
+	return fmt.Sprintf("%s/output/%s-token", creator, uuid)
+}
+
+// JobManifestPath returns the path of the manifest of a job, uploaded by the job with a signed URL.
+func JobManifestPath(creator string, uuid string) string {
+	return fmt.Sprintf("%s/output/%s-manifest.json", creator, uuid)
+}
+
+func getBucket(env string) (string, error) {
 	if env == "" {
-		return "", errors.Wrap(fmt.Errorf("ENV environment variable is not present"), "")
+		return "", errors.Wrap(fmt.Errorf("env is not configured"), "")
 	}
 	return fmt.Sprintf("dcr-%s-hub", env), nil
 }
 
 func GetStorage(ctx context.Context) (Storage, error) {
-	storageType := os.Getenv("STORAGE_TYPE")
-	if storageType == "" {
-		storageType = "MOCK"
-	}
+	cfg := config.Get()
 	var storage Storage
-	bucket, err := getBucket()
+	bucket, err := getBucket(cfg.Env)
 	if err != nil {
 		return storage, err
 	}
-	if storageType == "GCP" {
+	switch cfg.StorageType {
+	case "GCP":
 		storage, err = NewGoogleCloudStorage(ctx, bucket)
-	} else if storageType == "MINIO" {
-		storage, err = NewMinioStorage(ctx, bucket)
-	} else if storageType == "MOCK" {
+	case "MINIO":
+		storage, err = NewMinioStorage(ctx, bucket, cfg.Minio)
+	case "MOCK":
 		storage = NewMockStorage(ctx)
+	default:
+		err = fmt.Errorf("unknown storage type %s", cfg.StorageType)
 	}
 	return storage, err
 }
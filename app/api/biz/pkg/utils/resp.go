@@ -53,7 +53,12 @@ func baseResp(err errno.ErrNo) *BaseResp {
 }
 
 func ReturnsJSONError(c *app.RequestContext, err error) {
+	ReturnsJSONErrorWithStatus(c, http.StatusOK, err)
+}
+
+// ReturnsJSONErrorWithStatus is like ReturnsJSONError, but sets the given HTTP status code
+func ReturnsJSONErrorWithStatus(c *app.RequestContext, statusCode int, err error) {
 	resp := BuildBaseResp(err)
-	c.JSON(http.StatusOK, gin.H{"code": resp.StatusCode, "msg": resp.StatusMsg})
+	c.JSON(statusCode, gin.H{"code": resp.StatusCode, "msg": resp.StatusMsg})
 	c.Abort()
 }
@@ -19,12 +19,27 @@ func Register(r *server.Hertz) {
 	root := r.Group("/", rootMw()...)
 	{
 		_v1 := root.Group("/v1", _v1Mw()...)
+		_v1.GET("/usage", append(_queryusageMw(), job.QueryUsage)...)
 		{
 			_job := _v1.Group("/job", _jobMw()...)
+			_job.GET("/:uuid", append(_queryjobdetailMw(), job.QueryJobDetail)...)
+			{
+				_uuid := _job.Group("/:uuid", _uuidMw()...)
+				_uuid.GET("/build-logs", append(_queryjobbuildlogsMw(), job.QueryJobBuildLogs)...)
+				_uuid.GET("/events", append(_queryjobeventsMw(), job.QueryJobEvents)...)
+				{
+					_attestation0 := _uuid.Group("/attestation", _attestation0Mw()...)
+					_attestation0.GET("/verify", append(_verifyjobattestationMw(), job.VerifyJobAttestation)...)
+				}
+			}
 			{
 				_attestation := _job.Group("/attestation", _attestationMw()...)
 				_attestation.POST("/", append(_queryjobattestationreportMw(), job.QueryJobAttestationReport)...)
 			}
+			{
+				_cancel := _job.Group("/cancel", _cancelMw()...)
+				_cancel.POST("/", append(_canceljobMw(), job.CancelJob)...)
+			}
 			{
 				_delete := _job.Group("/delete", _deleteMw()...)
 				_delete.POST("/", append(_deletejobMw(), job.DeleteJob)...)
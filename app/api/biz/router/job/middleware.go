@@ -4,11 +4,12 @@ package job
 
 import (
 	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/manatee-project/manatee/app/api/biz/mw"
 )
 
 func rootMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	return []app.HandlerFunc{mw.Authentication()}
 }
 
 func _v1Mw() []app.HandlerFunc {
@@ -100,3 +101,48 @@ func _submitjobMw() []app.HandlerFunc {
 	// your code...
 	return nil
 }
+
+func _cancelMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _canceljobMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _queryjobdetailMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _uuidMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _queryjobeventsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _queryjobbuildlogsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _queryusageMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _attestation0Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _verifyjobattestationMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
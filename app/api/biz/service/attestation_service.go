@@ -0,0 +1,136 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package service
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/rsa"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/manatee-project/manatee/app/api/biz/dal/db"
+	"github.com/manatee-project/manatee/app/api/biz/model/job"
+	"github.com/manatee-project/manatee/app/api/biz/pkg/attestation"
+	"github.com/manatee-project/manatee/app/api/biz/pkg/config"
+	"github.com/manatee-project/manatee/app/api/biz/pkg/errno"
+	"github.com/pkg/errors"
+)
+
+var (
+	verifierMu sync.Mutex
+	verifier   *attestation.Verifier
+)
+
+// getAttestationVerifier creates the verifier on first use, so that the API starts even if the issuer is unreachable.
+func getAttestationVerifier(ctx context.Context) (*attestation.Verifier, error) {
+	verifierMu.Lock()
+	defer verifierMu.Unlock()
+	if verifier == nil {
+		cfg := config.Get().Attestation
+		var v *attestation.Verifier
+		var err error
+		if cfg.MockEnabled {
+			// mock TEEs sign their tokens with the mock key instead of the keys of the issuer
+			var key *rsa.PrivateKey
+			if key, err = attestation.LoadMockKey(cfg.MockKeyFile); err == nil {
+				v, err = attestation.NewMockVerifier(key, cfg.Audience)
+			}
+		} else {
+			v, err = attestation.NewVerifier(ctx, attestation.Config{
+				Issuer:       cfg.Issuer,
+				JWKSFile:     cfg.JWKSFile,
+				Audience:     cfg.Audience,
+				RootCertFile: cfg.RootCertFile,
+			})
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create attestation verifier")
+		}
+		verifier = v
+	}
+	return verifier, nil
+}
+
+// VerifyJobAttestation verifies the attestation token of a finished job against its output and image.
+func (js *JobService) VerifyJobAttestation(req *job.VerifyJobAttestationRequest) (bool, []*job.AttestationCheck, error) {
+	j, err := db.QueryJobByUUIDAndCreator(req.Creator, req.UUID)
+	if err != nil {
+		return false, nil, err
+	}
+	if job.JobStatus(j.JobStatus) != job.JobStatus_VMFinished {
+		return false, nil, errno.AttestationUnavailableErr.WithMessage(fmt.Sprintf("the job is %s, only finished jobs are attested", job.JobStatus(j.JobStatus)))
+	}
+	token, err := js.readJobFile(js.getJobTokenPath(j.Creator, j.UUID))
+	if err != nil {
+		return false, nil, errno.AttestationUnavailableErr.WithMessage(fmt.Sprintf("failed to read the attestation token: %v", err))
+	}
+	nonce, err := js.getExpectedNonce(j)
+	if err != nil {
+		return false, nil, err
+	}
+	v, err := getAttestationVerifier(js.ctx)
+	if err != nil {
+		return false, nil, err
+	}
+	expected := attestation.Expected{
+		Nonce:       nonce,
+		ImageDigest: j.DockerImageDigest,
+		Audience:    j.AttestationAudience,
+		TokenType:   j.AttestationTokenType,
+	}
+	// tokens are short-lived, so they are checked against the time the job finished
+	if j.FinishedAt != nil {
+		expected.Time = *j.FinishedAt
+	}
+	verdict := v.Verify(strings.TrimSpace(string(token)), expected)
+	checks := make([]*job.AttestationCheck, 0, len(verdict.Checks))
+	for _, c := range verdict.Checks {
+		checks = append(checks, &job.AttestationCheck{Name: c.Name, Passed: c.Passed, Detail: c.Detail})
+	}
+	return verdict.Verified, checks, nil
+}
+
+func (js *JobService) readJobFile(remotePath string) ([]byte, error) {
+	reader, err := js.storage.DownloadFile(remotePath)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	return io.ReadAll(reader)
+}
+
+// getExpectedNonce recomputes the nonce the executor requested its token with from the output of the job.
+// It is the digest of the job manifest, or the md5 of the output for the jobs submitted before manifests.
+func (js *JobService) getExpectedNonce(j *db.Job) (string, error) {
+	reader, err := js.storage.DownloadFile(js.getJobOutputPath(j.Creator, j.UUID, j.JupyterFileName))
+	if err != nil {
+		return "", errors.Wrap(err, "failed to download job output")
+	}
+	defer reader.Close()
+	if j.WorkspaceHash == "" {
+		hash := md5.New()
+		if _, err := io.Copy(hash, reader); err != nil {
+			return "", errors.Wrap(err, "failed to hash job output")
+		}
+		return fmt.Sprintf("%x", hash.Sum(nil)), nil
+	}
+	manifest, err := attestation.NewManifest(j.UUID, j.WorkspaceHash, j.JupyterFileName, reader)
+	if err != nil {
+		return "", err
+	}
+	return manifest.Nonce()
+}
@@ -0,0 +1,38 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/manatee-project/manatee/app/api/biz/dal/db"
+	"github.com/manatee-project/manatee/app/api/biz/pkg/attestation"
+	"github.com/manatee-project/manatee/app/api/biz/pkg/storage"
+)
+
+func TestGetExpectedNonce(t *testing.T) {
+	ctx := context.Background()
+	js := &JobService{ctx: ctx, storage: storage.NewMockStorage(ctx)}
+	j := &db.Job{UUID: "uuid", Creator: "alice", JupyterFileName: "test.ipynb"}
+	nonce, err := js.getExpectedNonce(j)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the mock storage serves empty files, and jobs without a workspace hash use what md5sum prints for them
+	if nonce != "d41d8cd98f00b204e9800998ecf8427e" {
+		t.Errorf("unexpected md5 nonce %s", nonce)
+	}
+
+	j.WorkspaceHash = "abcd"
+	nonce, err = js.getExpectedNonce(j)
+	if err != nil {
+		t.Fatal(err)
+	}
+	manifest, err := attestation.NewManifest("uuid", "abcd", "test.ipynb", strings.NewReader(""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected, _ := manifest.Nonce(); nonce != expected {
+		t.Errorf("expected manifest nonce %s, got %s", expected, nonce)
+	}
+}
@@ -18,34 +18,59 @@ import (
 	"bytes"
 	"compress/gzip"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"io"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/cloudwego/hertz/pkg/common/hlog"
 	"github.com/google/uuid"
 	"github.com/manatee-project/manatee/app/api/biz/dal/db"
 	"github.com/manatee-project/manatee/app/api/biz/model/job"
+	"github.com/manatee-project/manatee/app/api/biz/pkg/attestation"
+	"github.com/manatee-project/manatee/app/api/biz/pkg/config"
 	"github.com/manatee-project/manatee/app/api/biz/pkg/errno"
 	"github.com/manatee-project/manatee/app/api/biz/pkg/storage"
+	"github.com/manatee-project/manatee/app/reconciler/imagebuilder"
 	"github.com/pkg/errors"
 )
 
+// redactedEnvValue replaces env values in API responses, as they may carry user secrets.
+const redactedEnvValue = "<redacted>"
+
 type JobService struct {
-	ctx     context.Context
-	storage storage.Storage
+	ctx        context.Context
+	storage    storage.Storage
+	builder    imagebuilder.ImageBuilder
+	builderErr error
 }
 
+// newImageBuilder creates the image builder once, it is shared by the job services of all requests.
+var newImageBuilder = sync.OnceValues(func() (imagebuilder.ImageBuilder, error) {
+	builder, err := imagebuilder.NewKanikoImageBuilder()
+	if err != nil {
+		return nil, err
+	}
+	return builder, nil
+})
+
 // NewJobService create job service
 func NewJobService(ctx context.Context) *JobService {
 	storage, err := storage.GetStorage(ctx)
 	if err != nil {
 		panic(err)
 	}
+	// the image builder is only needed for the build logs, which are unavailable if it failed to initialize
+	builder, builderErr := newImageBuilder()
 	return &JobService{
-		ctx:     ctx,
-		storage: storage,
+		ctx:        ctx,
+		storage:    storage,
+		builder:    builder,
+		builderErr: builderErr,
 	}
 }
 
@@ -53,14 +78,32 @@ func (js *JobService) Drop() {
 	js.storage.Close()
 }
 
-func (js *JobService) SubmitJob(req *job.SubmitJobRequest, userWorkspace io.Reader) (string, error) {
+// SubmitJob queues a job of the creator, which counts against the quotas of groups.
+// The reconciler starts it once it fits in the quotas.
+func (js *JobService) SubmitJob(req *job.SubmitJobRequest, groups []string, userWorkspace io.Reader) (string, error) {
 	creator := req.Creator
 
-	jobInList, err := db.GetInProgressJobs(creator)
+	// the queue only bounds the pending work of a user, the quotas are enforced by the reconciler
+	if limit := config.Get().Quota.MaxQueuedJobsPerUser; limit > 0 {
+		queued, err := db.CountQueuedJobs(creator)
+		if err != nil {
+			return "", err
+		} else if queued >= int64(limit) {
+			return "", errno.ReachJobLimitErr.WithMessage(fmt.Sprintf("%s already has %d queued jobs", creator, queued))
+		}
+	}
+
+	timeout, err := getJobTimeout(req.Timeout, config.Get().Job)
+	if err != nil {
+		return "", err
+	}
+	resources, err := getJobResources(req.Resources, config.Get().Resources)
+	if err != nil {
+		return "", err
+	}
+	priority, err := getJobPriority(req.Priority, groups, config.Get().Scheduler)
 	if err != nil {
 		return "", err
-	} else if len(jobInList) > 2 {
-		return "", errors.Wrap(fmt.Errorf("%s", errno.ReachJobLimitErrMsg), "")
 	}
 
 	var keys []string
@@ -70,65 +113,127 @@ func (js *JobService) SubmitJob(req *job.SubmitJobRequest, userWorkspace io.Read
 		extraEnvs[v.GetKey()] = v.GetValue()
 	}
 
-	// inject Dockerfile into the build context
+	// inject Dockerfile into the build context, hashing the workspace as submitted for the attestation
+	workspaceHash := sha256.New()
+	workspace := io.TeeReader(userWorkspace, workspaceHash)
 	dockerFileContent := js.generateDockerfile(keys)
-	buildctx, err := js.addDockerfileToTarGz(userWorkspace, string(dockerFileContent))
+	buildctx, err := js.addDockerfileToTarGz(workspace, string(dockerFileContent))
 	if err != nil {
 		return "", err
 	}
-	remotePath := fmt.Sprintf("%s/%s-workspace.tar.gz", creator, creator)
-	err = js.storage.UploadFile(buildctx, remotePath, false)
-	if err != nil {
-		return "", err
+	if _, err := io.Copy(io.Discard, workspace); err != nil {
+		return "", errors.Wrap(err, "failed to hash workspace")
 	}
-	buildctxpath := fmt.Sprintf("%s/%s/%s-workspace.tar.gz", js.storage.BucketPath(), creator, creator)
 
 	uuidStr, err := uuid.NewUUID()
 	if err != nil {
 		return "", errors.Wrap(err, "failed to generate uuid")
 	}
 
-	// generate signed put url for the output and custom token
-	outputPath := fmt.Sprintf("%s/output/out-%s-%s", creator, uuidStr.String(), req.JupyterFileName)
-	outputPutSignedUrl, err := js.storage.IssueSignedUrl(outputPath, "PUT", time.Hour*6)
-	if err != nil {
-		return "", err
-	}
-	customTokenPath := fmt.Sprintf("%s/output/%s-token", creator, uuidStr.String())
-	customTokenPathPutSignedUrl, err := js.storage.IssueSignedUrl(customTokenPath, "PUT", time.Hour*6)
+	// each job has its own build context, as a queued job may be built after the next submission of its creator
+	remotePath := fmt.Sprintf("%s/%s-workspace.tar.gz", creator, uuidStr.String())
+	err = js.storage.UploadFile(buildctx, remotePath, false)
 	if err != nil {
 		return "", err
 	}
+	buildctxpath := fmt.Sprintf("%s/%s", js.storage.BucketPath(), remotePath)
+
+	// the signed URLs the job uploads its results with are issued by the reconciler on admission, so that they
+	// last for the timeout of the job however long it is queued
 	t := db.Job{
-		UUID:                    uuidStr.String(),
-		Dockerfile:              dockerFileContent,
-		Creator:                 req.Creator,
-		JupyterFileName:         req.JupyterFileName,
-		JobStatus:               int(job.JobStatus_Created),
-		BuildContextPath:        buildctxpath,
-		OutputPutSignedUrl:      outputPutSignedUrl,
-		CustomTokenPutSignedUrl: customTokenPathPutSignedUrl,
-		ExtraEnvs:               extraEnvs,
+		UUID:                 uuidStr.String(),
+		Dockerfile:           dockerFileContent,
+		Creator:              req.Creator,
+		Groups:               groups,
+		JupyterFileName:      req.JupyterFileName,
+		JobStatus:            int(job.JobStatus_Queued),
+		BuildContextPath:     buildctxpath,
+		WorkspaceHash:        hex.EncodeToString(workspaceHash.Sum(nil)),
+		AttestationAudience:  config.Get().Attestation.Audience,
+		AttestationTokenType: config.Get().Attestation.TokenType,
+		ExtraEnvs:            extraEnvs,
+		TimeoutSeconds:       int64(timeout.Seconds()),
+		MachineType:          resources.MachineType,
+		DiskSizeGB:           resources.DiskSizeGb,
+		ConfidentialType:     resources.ConfidentialInstanceType,
+		Priority:             priority,
 	}
 	err = db.CreateJob(&t)
 
 	if err != nil {
 		return "", err
 	}
-	hlog.Infof("[JobService] inserted job. Job Status %+v", job.JobStatus_ImageBuilding)
+	hlog.Infof("[JobService] inserted job. Job Status %+v", job.JobStatus_Queued)
 	return uuidStr.String(), nil
 }
 
+// getJobTimeout resolves the timeout requested in seconds, 0 meaning the default, against the configured limits.
+func getJobTimeout(requested int64, limits config.JobConfig) (time.Duration, error) {
+	if requested == 0 {
+		return limits.DefaultTimeout, nil
+	}
+	if requested < 0 || requested > int64(limits.MaxTimeout.Seconds()) {
+		return 0, errno.JobTimeoutExceedsLimitErr.WithMessage(fmt.Sprintf("the job timeout %ds exceeds the limit of %v", requested, limits.MaxTimeout))
+	}
+	return time.Duration(requested) * time.Second, nil
+}
+
+// getJobResources fills the resource profile requested with the configured defaults and checks it against the allowlist.
+func getJobResources(requested *job.ResourceProfile, allowed config.ResourcesConfig) (*job.ResourceProfile, error) {
+	resources := &job.ResourceProfile{
+		MachineType:              allowed.MachineType,
+		DiskSizeGb:               allowed.DiskSizeGB,
+		ConfidentialInstanceType: allowed.ConfidentialInstanceType,
+	}
+	if requested != nil {
+		if requested.MachineType != "" {
+			resources.MachineType = requested.MachineType
+		}
+		if requested.DiskSizeGb != 0 {
+			resources.DiskSizeGb = requested.DiskSizeGb
+		}
+		if requested.ConfidentialInstanceType != "" {
+			resources.ConfidentialInstanceType = requested.ConfidentialInstanceType
+		}
+	}
+	err := allowed.Allows(resources.MachineType, resources.DiskSizeGb, resources.ConfidentialInstanceType)
+	if err != nil {
+		return nil, errno.ResourceNotAllowedErr.WithMessage(err.Error())
+	}
+	return resources, nil
+}
+
+// getJobPriority resolves the priority requested, 0 meaning the default, against the limit of the groups of the creator.
+func getJobPriority(requested int64, groups []string, scheduler config.SchedulerConfig) (int, error) {
+	if requested == 0 {
+		return scheduler.DefaultPriority, nil
+	}
+	if limit := scheduler.PriorityLimit(groups); requested < 0 || requested > int64(limit) {
+		return 0, errno.PriorityNotAllowedErr.WithMessage(fmt.Sprintf("the job priority %d exceeds the limit of %d", requested, limit))
+	}
+	return int(requested), nil
+}
+
 var dockerFileTemplate string = `ARG BASE_IMAGE
 ARG BASE_IMAGE
 FROM $BASE_IMAGE
 ARG OUTPUT_SIGNED_URL
 ARG JUPYTER_FILENAME
 ARG CUSTOMTOKEN_SIGNED_URL 
+ARG MANIFEST_SIGNED_URL
+ARG JOB_UUID
+ARG WORKSPACE_HASH
+ARG ATTESTATION_AUDIENCE
+ARG ATTESTATION_TOKEN_TYPE
 
 ENV OUTPUT_SIGNED_URL=$OUTPUT_SIGNED_URL
 ENV JUPYTER_FILENAME=$JUPYTER_FILENAME
 ENV CUSTOMTOKEN_SIGNED_URL=$CUSTOMTOKEN_SIGNED_URL
+ENV MANIFEST_SIGNED_URL=$MANIFEST_SIGNED_URL
+ENV JOB_UUID=$JOB_UUID
+ENV WORKSPACE_HASH=$WORKSPACE_HASH
+ENV ATTESTATION_AUDIENCE=$ATTESTATION_AUDIENCE
+ENV ATTESTATION_TOKEN_TYPE=$ATTESTATION_TOKEN_TYPE
 
 WORKDIR /home/jovyan
 COPY $USER_WORKSPACE/* ./
@@ -140,11 +245,11 @@ ENTRYPOINT rm -rf lm-evaluation-harness \
 	&& git checkout 3102a8e4a8f3a3163a52e943f14068680753356f \
 	&& popd \
 	&& pip install -e ./lm-evaluation-harness[wandb] \
-	&& jupyter nbconvert --execute --to notebook --inplace $JUPYTER_FILENAME --ExecutePreprocessor.timeout=-1 --allow-errors \
-    && hash=$(md5sum $JUPYTER_FILENAME | awk '{ print $1 }') \
-    && curl -X PUT -T $JUPYTER_FILENAME $OUTPUT_SIGNED_URL \
-    && ./gen_custom_token --nonce $hash \
-    && curl -X PUT -T custom_token $CUSTOMTOKEN_SIGNED_URL
+	&& jupyter nbconvert --execute --to notebook --inplace "$JUPYTER_FILENAME" --ExecutePreprocessor.timeout=-1 --allow-errors \
+    && curl -X PUT -T "$JUPYTER_FILENAME" "$OUTPUT_SIGNED_URL" \
+    && ./gen_custom_token --output "$JUPYTER_FILENAME" --job-uuid "$JOB_UUID" --workspace-hash "$WORKSPACE_HASH" --audience "$ATTESTATION_AUDIENCE" --token-type "$ATTESTATION_TOKEN_TYPE" \
+    && curl -X PUT -T custom_token "$CUSTOMTOKEN_SIGNED_URL" \
+    && curl -X PUT -T manifest.json "$MANIFEST_SIGNED_URL"
 `
 
 // TODO: this actually needs to support different TEE backends.
@@ -223,9 +328,90 @@ func convertEntityToModel(j *db.Job) *job.Job {
 		JupyterFileName: j.JupyterFileName,
 		CreatedAt:       j.CreatedAt.Format("2006-01-02 15:04:05"),
 		UpdatedAt:       j.UpdatedAt.Format("2006-01-02 15:04:05"),
+		FailureReason:   j.FailureReason,
+	}
+}
+
+// convertEntityToDetail converts the job with its provenance, env values are redacted.
+func convertEntityToDetail(j *db.Job) *job.JobDetail {
+	keys := make([]string, 0, len(j.ExtraEnvs))
+	for key := range j.ExtraEnvs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	envs := []*job.Env{}
+	for _, key := range keys {
+		envs = append(envs, &job.Env{Key: key, Value: redactedEnvValue})
+	}
+	return &job.JobDetail{
+		ID:                   int64(j.ID),
+		UUID:                 j.UUID,
+		Creator:              j.Creator,
+		JobStatus:            job.JobStatus(j.JobStatus),
+		JupyterFileName:      j.JupyterFileName,
+		CreatedAt:            j.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:            j.UpdatedAt.Format("2006-01-02 15:04:05"),
+		DockerImage:          j.DockerImage,
+		DockerImageDigest:    j.DockerImageDigest,
+		InstanceName:         j.InstanceName,
+		Dockerfile:           j.Dockerfile,
+		Envs:                 envs,
+		FailureReason:        j.FailureReason,
+		FailureDetail:        j.FailureDetail,
+		Timeout:              j.TimeoutSeconds,
+		Priority:             int64(j.Priority),
+		AttestationAudience:  j.AttestationAudience,
+		AttestationTokenType: j.AttestationTokenType,
+		Resources: &job.ResourceProfile{
+			MachineType:              j.MachineType,
+			DiskSizeGb:               j.DiskSizeGB,
+			ConfidentialInstanceType: j.ConfidentialType,
+		},
 	}
 }
 
+func (js *JobService) QueryJobDetail(req *job.QueryJobDetailRequest) (*job.JobDetail, error) {
+	j, err := db.QueryJobByUUIDAndCreator(req.Creator, req.UUID)
+	if err != nil {
+		return nil, err
+	}
+	return convertEntityToDetail(j), nil
+}
+
+func (js *JobService) QueryJobEvents(req *job.QueryJobEventsRequest) ([]*job.JobEvent, error) {
+	j, err := db.QueryJobByUUIDAndCreator(req.Creator, req.UUID)
+	if err != nil {
+		return nil, err
+	}
+	events, err := db.QueryJobEventsByUUID(j.UUID)
+	if err != nil {
+		return nil, err
+	}
+	res := []*job.JobEvent{}
+	for _, e := range events {
+		res = append(res, &job.JobEvent{
+			OldStatus: job.JobStatus(e.OldStatus),
+			NewStatus: job.JobStatus(e.NewStatus),
+			Reason:    e.Reason,
+			CreatedAt: e.CreatedAt.Format("2006-01-02 15:04:05"),
+		})
+	}
+	return res, nil
+}
+
+// GetJobBuildLogs streams the image build logs of the job, the caller must close the returned reader.
+// The stream ends when the context of the service is done, such as when the client disconnects.
+func (js *JobService) GetJobBuildLogs(req *job.QueryJobBuildLogsRequest) (io.ReadCloser, error) {
+	j, err := db.QueryJobByUUIDAndCreator(req.Creator, req.UUID)
+	if err != nil {
+		return nil, err
+	}
+	if js.builderErr != nil {
+		return nil, errors.Wrap(js.builderErr, "failed to init image builder")
+	}
+	return js.builder.GetBuildLogs(js.ctx, j.UUID, req.Follow, req.Tail)
+}
+
 func (js *JobService) QueryUsersJobs(req *job.QueryJobRequest) ([]*job.Job, int64, error) {
 	jobs, total, err := db.QueryJobsByCreator(req.Creator, req.Page, req.PageSize)
 	if err != nil {
@@ -257,17 +443,50 @@ func (js *JobService) DeleteJob(req *job.DeleteJobRequest) {
 	db.DeleteJob(req.Creator, req.UUID)
 }
 
-func (js *JobService) GetJobAttestationReport(req *job.QueryJobAttestationRequest) (string, error) {
+// CancelJob asks the reconciler to stop the image build or TEE instance of an in-progress job.
+func (js *JobService) CancelJob(req *job.CancelJobRequest) error {
+	j, err := db.QueryJobByUUIDAndCreator(req.Creator, req.UUID)
+	if err != nil {
+		return err
+	}
+	switch job.JobStatus(j.JobStatus) {
+	case job.JobStatus_Queued, job.JobStatus_Created, job.JobStatus_ImageBuilding, job.JobStatus_VMWaiting, job.JobStatus_VMRunning:
+	default:
+		return errno.JobNotCancellableErr
+	}
+	return db.RequestJobCancellation(j)
+}
+
+// GetJobAttestationReport returns the signed urls of the attestation token and of the manifest it attests,
+// and the type of the token.
+func (js *JobService) GetJobAttestationReport(req *job.QueryJobAttestationRequest) (*job.QueryJobAttestationResponse, error) {
 	j, err := db.QueryJobByIdAndCreator(req.ID, req.Creator)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
-	attestationReportPath := fmt.Sprintf("%s/output/%s-token", j.Creator, j.UUID)
+	attestationReportPath := js.getJobTokenPath(j.Creator, j.UUID)
 	signedUrl, err := js.storage.IssueSignedUrl(attestationReportPath, "GET", time.Hour)
 	if err != nil {
-		return "", nil
+		return nil, err
+	}
+	// jobs submitted before manifests were introduced have none
+	var manifestSignedUrl string
+	if j.WorkspaceHash != "" {
+		manifestSignedUrl, err = js.storage.IssueSignedUrl(js.getJobManifestPath(j.Creator, j.UUID), "GET", time.Hour)
+		if err != nil {
+			return nil, err
+		}
+	}
+	// jobs submitted before the token type was recorded requested OIDC tokens
+	tokenType := j.AttestationTokenType
+	if tokenType == "" {
+		tokenType = attestation.TokenTypeOIDC
 	}
-	return signedUrl, nil
+	return &job.QueryJobAttestationResponse{
+		SignedURL:         signedUrl,
+		ManifestSignedURL: manifestSignedUrl,
+		TokenType:         tokenType,
+	}, nil
 }
 
 func (js *JobService) getJobOutputFilename(UUID string, originName string) string {
@@ -275,5 +494,13 @@ func (js *JobService) getJobOutputFilename(UUID string, originName string) strin
 }
 
 func (js *JobService) getJobOutputPath(creator string, UUID string, originName string) string {
-	return fmt.Sprintf("%s/output/%s", creator, js.getJobOutputFilename(UUID, originName))
+	return storage.JobOutputPath(creator, UUID, originName)
+}
+
+func (js *JobService) getJobTokenPath(creator string, UUID string) string {
+	return storage.JobTokenPath(creator, UUID)
+}
+
+func (js *JobService) getJobManifestPath(creator string, UUID string) string {
+	return storage.JobManifestPath(creator, UUID)
 }
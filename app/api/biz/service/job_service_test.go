@@ -3,8 +3,14 @@ package service
 import (
 	"context"
 	"os"
+	"regexp"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/manatee-project/manatee/app/api/biz/dal/db"
+	"github.com/manatee-project/manatee/app/api/biz/model/job"
+	"github.com/manatee-project/manatee/app/api/biz/pkg/config"
 )
 
 var expectedDockerfile1 string = `ARG BASE_IMAGE
@@ -46,4 +52,92 @@ func TestGenerateDockerfile(t *testing.T) {
 	if !strings.Contains(content, `LABEL "tee.launch_policy.allow_env_override"="USER_TOKEN,CUSTOM_ENV_VAR,BREAKPOINT"`) {
 		t.Errorf("Dockerfile does not contain correct allow_env_override policy")
 	}
+	// the signed URLs contain shell metacharacters, so the expansions of the entrypoint are quoted
+	_, entrypoint, _ := strings.Cut(content, "ENTRYPOINT")
+	if unquoted := regexp.MustCompile(`[^"]\$[A-Z_]+`).FindString(entrypoint); unquoted != "" {
+		t.Errorf("Dockerfile entrypoint expands %q unquoted", unquoted)
+	}
+}
+
+func TestConvertEntityToDetail(t *testing.T) {
+	j := &db.Job{
+		UUID:              "job1",
+		DockerImage:       "my.image.registry/image@sha256:deadbeef",
+		DockerImageDigest: "deadbeef",
+		InstanceName:      "user1-job1",
+		ExtraEnvs:         map[string]string{"USER_TOKEN": "secret", "BREAKPOINT": "1"},
+	}
+	detail := convertEntityToDetail(j)
+	if detail.DockerImageDigest != "deadbeef" || detail.InstanceName != "user1-job1" {
+		t.Errorf("provenance is missing from job detail: %+v", detail)
+	}
+	if len(detail.Envs) != 2 || detail.Envs[0].Key != "BREAKPOINT" || detail.Envs[1].Key != "USER_TOKEN" {
+		t.Fatalf("unexpected envs %+v", detail.Envs)
+	}
+	for _, env := range detail.Envs {
+		if env.Value != redactedEnvValue {
+			t.Errorf("env %s is not redacted", env.Key)
+		}
+	}
+}
+
+func TestGetJobTimeout(t *testing.T) {
+	limits := config.JobConfig{DefaultTimeout: 6 * time.Hour, MaxTimeout: 24 * time.Hour}
+
+	timeout, err := getJobTimeout(0, limits)
+	if err != nil || timeout != 6*time.Hour {
+		t.Errorf("expected default timeout of 6h, got %v, %v", timeout, err)
+	}
+	timeout, err = getJobTimeout(12*3600, limits)
+	if err != nil || timeout != 12*time.Hour {
+		t.Errorf("expected timeout of 12h, got %v, %v", timeout, err)
+	}
+	if _, err = getJobTimeout(25*3600, limits); err == nil {
+		t.Errorf("expected error for timeout above the limit")
+	}
+	if _, err = getJobTimeout(-1, limits); err == nil {
+		t.Errorf("expected error for negative timeout")
+	}
+}
+
+func TestGetJobPriority(t *testing.T) {
+	scheduler := config.SchedulerConfig{DefaultPriority: 1, MaxPriority: 2, GroupMaxPriority: map[string]int{"oncall": 10}}
+
+	priority, err := getJobPriority(0, nil, scheduler)
+	if err != nil || priority != 1 {
+		t.Errorf("expected default priority of 1, got %d, %v", priority, err)
+	}
+	if _, err = getJobPriority(5, []string{"research"}, scheduler); err == nil {
+		t.Errorf("expected error for priority above the limit")
+	}
+	priority, err = getJobPriority(5, []string{"research", "oncall"}, scheduler)
+	if err != nil || priority != 5 {
+		t.Errorf("expected priority of 5 within the group limit, got %d, %v", priority, err)
+	}
+}
+
+func TestGetJobResources(t *testing.T) {
+	allowed := config.Default().Resources
+	allowed.AllowedMachineTypes = []string{"c3-standard-8", "n2d-highmem-32"}
+	allowed.AllowedConfidentialInstanceTypes = []string{"TDX", "SEV"}
+	allowed.MaxDiskSizeGB = 500
+
+	resources, err := getJobResources(nil, allowed)
+	if err != nil || resources.MachineType != "c3-standard-8" || resources.DiskSizeGb != 50 || resources.ConfidentialInstanceType != "TDX" {
+		t.Errorf("expected default resources, got %+v, %v", resources, err)
+	}
+	resources, err = getJobResources(&job.ResourceProfile{MachineType: "n2d-highmem-32", DiskSizeGb: 500, ConfidentialInstanceType: "SEV"}, allowed)
+	if err != nil || resources.MachineType != "n2d-highmem-32" || resources.DiskSizeGb != 500 || resources.ConfidentialInstanceType != "SEV" {
+		t.Errorf("expected requested resources, got %+v, %v", resources, err)
+	}
+	for _, requested := range []*job.ResourceProfile{
+		{MachineType: "a3-highgpu-8g"},
+		{DiskSizeGb: 501},
+		{DiskSizeGb: -1},
+		{ConfidentialInstanceType: "SEV_SNP"},
+	} {
+		if _, err := getJobResources(requested, allowed); err == nil {
+			t.Errorf("expected error for resources %+v", requested)
+		}
+	}
 }
@@ -0,0 +1,158 @@
+// Copyright 2024 TikTok Pte. Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/manatee-project/manatee/app/api/biz/dal/db"
+	"github.com/manatee-project/manatee/app/api/biz/model/job"
+	"github.com/manatee-project/manatee/app/api/biz/pkg/config"
+	"github.com/manatee-project/manatee/app/api/biz/pkg/errno"
+)
+
+// usageDateLayout is the layout of the dates bounding the usage, which are in UTC.
+const usageDateLayout = "2006-01-02"
+
+type UsageService struct {
+	ctx context.Context
+}
+
+// NewUsageService create usage service
+func NewUsageService(ctx context.Context) *UsageService {
+	return &UsageService{
+		ctx: ctx,
+	}
+}
+
+// QueryUsage returns the VM time and cost of the jobs of the creator of the request, or of all creators,
+// between its start and end, per creator and machine type or per job.
+func (us *UsageService) QueryUsage(req *job.QueryUsageRequest) ([]*job.UsageRecord, error) {
+	now := time.Now()
+	start, end, err := parseUsageRange(req.Start, req.End, now)
+	if err != nil {
+		return nil, err
+	}
+	jobs, err := db.QueryJobsOnVMBetween(req.Creator, start, end)
+	if err != nil {
+		return nil, err
+	}
+	return computeUsage(jobs, start, end, now, req.GroupBy == "job", config.Get().Billing.HourlyPrices), nil
+}
+
+// parseUsageRange parses the dates or RFC 3339 times bounding the usage, the end being excluded.
+// The end defaults to now, and the start to the beginning of the month of the end.
+func parseUsageRange(startValue, endValue string, now time.Time) (time.Time, time.Time, error) {
+	end := now
+	if endValue != "" {
+		t, err := parseUsageTime(endValue)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		end = t
+	}
+	start := time.Date(end.Year(), end.Month(), 1, 0, 0, 0, 0, time.UTC)
+	if startValue != "" {
+		t, err := parseUsageTime(startValue)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		start = t
+	}
+	if !start.Before(end) {
+		return time.Time{}, time.Time{}, errno.UsageRangeInvalidErr.WithMessage(fmt.Sprintf("the usage start %v is not before the end %v", start, end))
+	}
+	return start, end, nil
+}
+
+func parseUsageTime(value string) (time.Time, error) {
+	if t, err := time.Parse(usageDateLayout, value); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, errno.UsageRangeInvalidErr.WithMessage(fmt.Sprintf("%q is neither a date nor an RFC 3339 time", value))
+	}
+	return t, nil
+}
+
+// computeUsage sums the VM time of the jobs within start and end, jobs still running being counted until now.
+func computeUsage(jobs []*db.Job, start, end, now time.Time, perJob bool, hourlyPrices map[string]float64) []*job.UsageRecord {
+	type key struct{ creator, uuid, machineType string }
+	records := make(map[key]*job.UsageRecord)
+	for _, j := range jobs {
+		vmTime := j.VMTime(start, end, now)
+		if vmTime <= 0 {
+			continue
+		}
+		k := key{creator: j.Creator, machineType: j.MachineType}
+		if perJob {
+			k.uuid = j.UUID
+		}
+		r, ok := records[k]
+		if !ok {
+			r = &job.UsageRecord{Creator: k.creator, UUID: k.uuid, MachineType: k.machineType}
+			records[k] = r
+		}
+		seconds := int64(vmTime.Seconds())
+		r.Jobs++
+		r.VMSeconds += seconds
+		r.Cost += float64(seconds) / 3600 * hourlyPrices[j.MachineType]
+	}
+
+	res := make([]*job.UsageRecord, 0, len(records))
+	for _, r := range records {
+		res = append(res, r)
+	}
+	sort.Slice(res, func(a, b int) bool {
+		if res[a].Creator != res[b].Creator {
+			return res[a].Creator < res[b].Creator
+		}
+		if res[a].MachineType != res[b].MachineType {
+			return res[a].MachineType < res[b].MachineType
+		}
+		return res[a].UUID < res[b].UUID
+	})
+	return res
+}
+
+// WriteUsageCSV writes the usage records as CSV with a header row.
+func WriteUsageCSV(w io.Writer, records []*job.UsageRecord, currency string) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"creator", "uuid", "machine_type", "jobs", "vm_seconds", "cost", "currency"}); err != nil {
+		return err
+	}
+	for _, r := range records {
+		row := []string{
+			r.Creator,
+			r.UUID,
+			r.MachineType,
+			strconv.FormatInt(r.Jobs, 10),
+			strconv.FormatInt(r.VMSeconds, 10),
+			strconv.FormatFloat(r.Cost, 'f', 2, 64),
+			currency,
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,74 @@
+package service
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/manatee-project/manatee/app/api/biz/dal/db"
+	"github.com/manatee-project/manatee/app/api/biz/model/job"
+)
+
+func TestParseUsageRange(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	start, end, err := parseUsageRange("", "", now)
+	if err != nil || !start.Equal(time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)) || !end.Equal(now) {
+		t.Errorf("expected the current month, got %v, %v, %v", start, end, err)
+	}
+	start, end, err = parseUsageRange("2026-09-01", "2026-10-01T00:00:00Z", now)
+	if err != nil || !start.Equal(time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)) || !end.Equal(time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected September, got %v, %v, %v", start, end, err)
+	}
+	if _, _, err = parseUsageRange("2026-10-01", "2026-09-01", now); err == nil {
+		t.Errorf("expected error for start after end")
+	}
+	if _, _, err = parseUsageRange("last month", "", now); err == nil {
+		t.Errorf("expected error for invalid start")
+	}
+}
+
+func TestComputeUsage(t *testing.T) {
+	at := func(day, hour int) *time.Time {
+		t := time.Date(2026, 9, day, hour, 0, 0, 0, time.UTC)
+		return &t
+	}
+	start, end, now := *at(1, 0), *at(30, 0), *at(20, 0)
+	jobs := []*db.Job{
+		{UUID: "job1", Creator: "alice", MachineType: "c3-standard-8", StartedAt: at(2, 0), FinishedAt: at(2, 2)},
+		// started before the range
+		{UUID: "job2", Creator: "alice", MachineType: "c3-standard-8", StartedAt: at(1, -1), FinishedAt: at(1, 1)},
+		// still running
+		{UUID: "job3", Creator: "alice", MachineType: "c3-highmem-88", StartedAt: at(19, 20)},
+		{UUID: "job4", Creator: "bob", MachineType: "c3-standard-8", StartedAt: at(3, 0), FinishedAt: at(3, 3)},
+		// never ran
+		{UUID: "job5", Creator: "bob", MachineType: "c3-standard-8", FinishedAt: at(3, 0)},
+	}
+	prices := map[string]float64{"c3-standard-8": 0.5}
+
+	expected := []*job.UsageRecord{
+		{Creator: "alice", MachineType: "c3-highmem-88", Jobs: 1, VMSeconds: 4 * 3600},
+		{Creator: "alice", MachineType: "c3-standard-8", Jobs: 2, VMSeconds: 3 * 3600, Cost: 1.5},
+		{Creator: "bob", MachineType: "c3-standard-8", Jobs: 1, VMSeconds: 3 * 3600, Cost: 1.5},
+	}
+	usage := computeUsage(jobs, start, end, now, false, prices)
+	if len(usage) != len(expected) {
+		t.Fatalf("expected %d records, got %d", len(expected), len(usage))
+	}
+	for i := range expected {
+		if *usage[i] != *expected[i] {
+			t.Errorf("expected %+v, got %+v", expected[i], usage[i])
+		}
+	}
+	if usage = computeUsage(jobs, start, end, now, true, prices); len(usage) != 4 || usage[1].UUID != "job1" || usage[1].VMSeconds != 2*3600 {
+		t.Errorf("expected usage per job, got %+v", usage)
+	}
+
+	var buf bytes.Buffer
+	if err := WriteUsageCSV(&buf, expected[2:], "USD"); err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != "creator,uuid,machine_type,jobs,vm_seconds,cost,currency\nbob,,c3-standard-8,1,10800,1.50,USD\n" {
+		t.Errorf("unexpected csv %q", buf.String())
+	}
+}
@@ -11,6 +11,8 @@ enum JobStatus {
     VMFailed = 7
     VMOther = 8
     VMLaunchFailed = 9
+    Timeout = 10
+    Queued = 11
 }
 
 struct Job {
@@ -21,6 +23,7 @@ struct Job {
     5: string jupyter_file_name
     6: string created_at
     7: string updated_at
+    8: string failure_reason
 }
 
 struct Env {
@@ -28,10 +31,41 @@ struct Env {
     2: string value
 }
 
+struct ResourceProfile {
+    1: string machine_type
+    2: i64 disk_size_gb
+    3: string confidential_instance_type
+}
+
+struct JobDetail {
+    1: i64 id
+    2: string uuid
+    3: string creator
+    4: JobStatus job_status
+    5: string jupyter_file_name
+    6: string created_at
+    7: string updated_at
+    8: string docker_image
+    9: string docker_image_digest
+    10: string instance_name
+    11: string dockerfile
+    12: list<Env> envs
+    13: string failure_reason
+    14: string failure_detail
+    15: i64 timeout
+    16: ResourceProfile resources
+    17: i64 priority
+    18: string attestation_audience
+    19: string attestation_token_type
+}
+
 struct SubmitJobRequest{
     1: string jupyter_file_name (api.body="filename", api.vd="len($) > 0 && len($) < 128 && regexp('^.*\\.ipynb$') && !regexp('.*\\.\\..*')")
     2: string creator (api.body="creator", api.vd="len($) > 0 && len($) < 32 && !regexp('.*\\.\\..*')") 
     3: list<Env> envs (api.body="envs", api.json="envs")
+    4: i64 timeout (api.body="timeout", api.vd="$ >= 0")
+    5: ResourceProfile resources (api.body="resources")
+    6: i64 priority (api.body="priority", api.vd="$ >= 0")
     255: required string access_token     (api.header="Authorization")
 }
 
@@ -66,6 +100,61 @@ struct DeleteJobResponse {
     2: string msg
 }
 
+struct CancelJobRequest {
+    1: string uuid (api.body="uuid", api.query="uuid", api.vd="len($) > 0")
+    2: string creator (api.body="creator", api.vd="len($) > 0 && len($) < 32 && !regexp('.*\\.\\..*')")
+    255: required string access_token     (api.header="Authorization")
+}
+
+struct CancelJobResponse {
+    1: i32 code
+    2: string msg
+}
+
+struct QueryJobDetailRequest {
+    1: string uuid (api.path="uuid", api.vd="len($) > 0")
+    2: string creator (api.query="creator", api.vd="len($) < 32 && !regexp('.*\\.\\..*')")
+    255: required string access_token     (api.header="Authorization")
+}
+
+struct QueryJobDetailResponse {
+    1: i32 code
+    2: string msg
+    3: JobDetail job
+}
+
+struct JobEvent {
+    1: JobStatus old_status
+    2: JobStatus new_status
+    3: string reason
+    4: string created_at
+}
+
+struct QueryJobEventsRequest {
+    1: string uuid (api.path="uuid", api.vd="len($) > 0")
+    2: string creator (api.query="creator", api.vd="len($) < 32 && !regexp('.*\\.\\..*')")
+    255: required string access_token     (api.header="Authorization")
+}
+
+struct QueryJobEventsResponse {
+    1: i32 code
+    2: string msg
+    3: list<JobEvent> events
+}
+
+struct QueryJobBuildLogsRequest {
+    1: string uuid (api.path="uuid", api.vd="len($) > 0")
+    2: string creator (api.query="creator", api.vd="len($) < 32 && !regexp('.*\\.\\..*')")
+    3: bool follow (api.query="follow")
+    4: i64 tail (api.query="tail", api.vd="$ >= 0")
+    255: required string access_token     (api.header="Authorization")
+}
+
+struct QueryJobBuildLogsResponse {
+    1: i32 code
+    2: string msg
+}
+
 struct DownloadJobOutputRequest {
     1: i64 id (api.body="id", api.query="id", api.vd="$>0")
     2: string creator (api.body="creator", api.vd="len($) > 0 && len($) < 32 && !regexp('.*\\.\\..*')")
@@ -82,18 +171,71 @@ struct DownloadJobOutputResponse {
 struct QueryJobAttestationRequest {
     1: i64 id (api.body="id", api.query="id", api.vd="$>0")
     2: string creator (api.body="creator", api.vd="len($) > 0 && len($) < 32 && !regexp('.*\\.\\..*')")
+    255: required string access_token     (api.header="Authorization")
 }
 
 struct QueryJobAttestationResponse {
     1: i32 code
     2: string msg
     3: string signed_url
+    4: string manifest_signed_url
+    5: string token_type
+}
+
+struct AttestationCheck {
+    1: string name
+    2: bool passed
+    3: string detail
+}
+
+struct VerifyJobAttestationRequest {
+    1: string uuid (api.path="uuid", api.vd="len($) > 0")
+    2: string creator (api.query="creator", api.vd="len($) < 32 && !regexp('.*\\.\\..*')")
+    255: required string access_token     (api.header="Authorization")
+}
+
+struct VerifyJobAttestationResponse {
+    1: i32 code
+    2: string msg
+    3: bool verified
+    4: list<AttestationCheck> checks
+}
+
+struct UsageRecord {
+    1: string creator
+    2: string uuid
+    3: string machine_type
+    4: i64 jobs
+    5: i64 vm_seconds
+    6: double cost
+}
+
+struct QueryUsageRequest {
+    1: string start (api.query="start")
+    2: string end (api.query="end")
+    3: string creator (api.query="creator", api.vd="len($) < 32 && !regexp('.*\\.\\..*')")
+    4: string group_by (api.query="group_by", api.vd="$ == '' || $ == 'creator' || $ == 'job'")
+    5: string format (api.query="format", api.vd="$ == '' || $ == 'json' || $ == 'csv'")
+    255: required string access_token     (api.header="Authorization")
+}
+
+struct QueryUsageResponse {
+    1: i32 code
+    2: string msg
+    3: list<UsageRecord> usage
+    4: string currency
 }
 
 service JobHandler {
     SubmitJobResponse SubmitJob(1:SubmitJobRequest req)(api.post="/v1/job/submit/")
     QueryJobResponse QueryJob(1:QueryJobRequest req)(api.post="/v1/job/query/")
+    QueryJobDetailResponse QueryJobDetail(1:QueryJobDetailRequest req)(api.get="/v1/job/:uuid")
+    QueryJobEventsResponse QueryJobEvents(1:QueryJobEventsRequest req)(api.get="/v1/job/:uuid/events")
+    QueryJobBuildLogsResponse QueryJobBuildLogs(1:QueryJobBuildLogsRequest req)(api.get="/v1/job/:uuid/build-logs")
     DeleteJobResponse DeleteJob(1:DeleteJobRequest req)(api.post="/v1/job/delete/")
+    CancelJobResponse CancelJob(1:CancelJobRequest req)(api.post="/v1/job/cancel/")
     DownloadJobOutputResponse DownloadJobOutput(1:DownloadJobOutputRequest req) (api.post="/v1/job/output/download/")
     QueryJobAttestationResponse QueryJobAttestationReport(1:QueryJobAttestationRequest req)  (api.post="/v1/job/attestation/")
+    VerifyJobAttestationResponse VerifyJobAttestation(1:VerifyJobAttestationRequest req)(api.get="/v1/job/:uuid/attestation/verify")
+    QueryUsageResponse QueryUsage(1:QueryUsageRequest req)(api.get="/v1/usage")
 }
\ No newline at end of file
@@ -6,15 +6,21 @@ import (
 	"github.com/cloudwego/hertz/pkg/app/server"
 
 	"github.com/manatee-project/manatee/app/api/biz/dal"
+	"github.com/manatee-project/manatee/app/api/biz/mw"
+	"github.com/manatee-project/manatee/app/api/biz/pkg/config"
 )
 
 func Init() {
+	config.Init()
 	dal.Init()
+	mw.Init()
 }
 
 func main() {
 	Init()
-	h := server.Default(server.WithHostPorts(":8080"), server.WithMaxRequestBodySize(6*1024*1024*1024))
+	// sensing client disconnections cancels the request context, which ends the streams of build logs being followed
+	h := server.Default(server.WithHostPorts(":8080"), server.WithMaxRequestBodySize(6*1024*1024*1024),
+		server.WithSenseClientDisconnection(true))
 
 	register(h)
 	h.Spin()
@@ -10,6 +10,8 @@ import (
 // customizeRegister registers customize routers.
 func customizedRegister(r *server.Hertz) {
 	r.GET("/health", handler.Health)
+	// public like the keys of the Confidential Space issuer, so it is not behind the authentication
+	r.GET("/.well-known/mock-attestation/jwks.json", handler.MockAttestationJWKS)
 
 	// your code ...
 }
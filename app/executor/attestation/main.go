@@ -25,12 +25,28 @@ import (
 	"net/http"
 	"os"
 	"strings"
+	"time"
 
+	"github.com/manatee-project/manatee/app/api/biz/pkg/attestation"
 	"github.com/pkg/errors"
 )
 
+// TikTokAudience is the default audience, deployments set their own with --audience.
 const TikTokAudience = "https://research.tiktok.com/"
 const TokenFilename = "custom_token"
+const ManifestFilename = "manifest.json"
+
+// stringList is a flag that can be given several times.
+type stringList []string
+
+func (l *stringList) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *stringList) Set(value string) error {
+	*l = append(*l, value)
+	return nil
+}
 
 type CustomToken struct {
 	Audience  string   `json:"audience"`
@@ -38,12 +54,7 @@ type CustomToken struct {
 	TokenType string   `json:"token_type"`
 }
 
-func GcsCustomAttestationToken(nonce string) ([]byte, error) {
-	request := CustomToken{
-		Audience:  TikTokAudience,
-		Nonces:    []string{nonce},
-		TokenType: "OIDC",
-	}
+func GcsCustomAttestationToken(request CustomToken) ([]byte, error) {
 	httpClient := http.Client{
 		Transport: &http.Transport{
 			DialContext: func(_ context.Context, _, _ string) (net.Conn, error) {
@@ -69,11 +80,29 @@ func GcsCustomAttestationToken(nonce string) ([]byte, error) {
 	return tokenbytes, nil
 }
 
-func generateCustomAttestationToken(nonce string) ([]byte, error) {
+// mockCustomAttestationToken signs a token with the key the mock TEE backend mounts in the workload.
+func mockCustomAttestationToken(request CustomToken) ([]byte, error) {
+	keyFile := os.Getenv("MOCK_ATTESTATION_KEY_FILE")
+	if _, err := os.Stat(keyFile); keyFile == "" || err != nil {
+		fmt.Printf("WARNING: no mock attestation key is mounted, the token is not signed \n")
+		return []byte(fmt.Sprintf("mock tee token with nonce %s", strings.Join(request.Nonces, ","))), nil
+	}
+	key, err := attestation.LoadMockKey(keyFile)
+	if err != nil {
+		return nil, err
+	}
+	token, err := attestation.SignMockToken(key, request.TokenType, request.Audience, request.Nonces, os.Getenv("MOCK_IMAGE_DIGEST"), time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return []byte(token), nil
+}
+
+func generateCustomAttestationToken(request CustomToken) ([]byte, error) {
 	if os.Getenv("TEE_BACKEND") == "MOCK" {
-		return []byte(fmt.Sprintf("mock tee token with nonce %s", nonce)), nil
+		return mockCustomAttestationToken(request)
 	} else {
-		return GcsCustomAttestationToken(nonce)
+		return GcsCustomAttestationToken(request)
 	}
 }
 
@@ -85,11 +114,57 @@ func requireParameter(name string, para string) {
 	}
 }
 
+// writeManifest writes the manifest of the job output and returns the nonce binding the token to it.
+func writeManifest(output string, jobUUID string, workspaceHash string) (string, error) {
+	f, err := os.Open(output)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to open output")
+	}
+	defer f.Close()
+	manifest, err := attestation.NewManifest(jobUUID, workspaceHash, output, f)
+	if err != nil {
+		return "", err
+	}
+	data, err := manifest.Marshal()
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal manifest")
+	}
+	if err := os.WriteFile(ManifestFilename, data, 0644); err != nil {
+		return "", errors.Wrap(err, "failed to write manifest")
+	}
+	return manifest.Nonce()
+}
+
 func main() {
-	nonce := flag.String("nonce", "", "The nonce to generate custom token")
+	var nonces stringList
+	flag.Var(&nonces, "nonce", "A nonce to generate custom token with, can be repeated")
+	output := flag.String("output", "", "The output file to attest, the digest of its manifest is added to the nonces")
+	jobUUID := flag.String("job-uuid", "", "The uuid of the job, recorded in the manifest")
+	workspaceHash := flag.String("workspace-hash", "", "The sha256 of the job workspace as computed by the API, recorded in the manifest without being measured")
+	audience := flag.String("audience", TikTokAudience, "The audience of the custom token")
+	tokenType := flag.String("token-type", attestation.TokenTypeOIDC, "The type of the custom token, OIDC or PKI with the certificate chain of the signing key")
 	flag.Parse()
-	requireParameter("nonce", *nonce)
-	customToken, err := generateCustomAttestationToken(*nonce)
+	if *output != "" {
+		requireParameter("job-uuid", *jobUUID)
+		requireParameter("workspace-hash", *workspaceHash)
+		nonce, err := writeManifest(*output, *jobUUID, *workspaceHash)
+		if err != nil {
+			fmt.Printf("ERROR: failed to write manifest %+v \n", err)
+			log.Fatal(err)
+		}
+		nonces = append(stringList{nonce}, nonces...)
+	}
+	requireParameter("nonce", nonces.String())
+	requireParameter("audience", *audience)
+	if *tokenType != attestation.TokenTypeOIDC && *tokenType != attestation.TokenTypePKI {
+		fmt.Printf("ERROR: unknown token type %s, supported are %s and %s \n", *tokenType, attestation.TokenTypeOIDC, attestation.TokenTypePKI)
+		os.Exit(1)
+	}
+	customToken, err := generateCustomAttestationToken(CustomToken{
+		Audience:  *audience,
+		Nonces:    nonces,
+		TokenType: *tokenType,
+	})
 	if err != nil {
 		fmt.Printf("ERROR: failed to generate custom token %+v \n", err)
 		panic(err)
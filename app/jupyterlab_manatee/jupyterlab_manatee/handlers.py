@@ -70,7 +70,10 @@ class DataCleanRoomJobHandler(JupyterHandler):
     A Job Handler for Data Clean Room API.
     """
 
-    def _build_form_data(self, workspace_file, creator, jupyter_filename, envs) -> FormData:
+    # optional job settings forwarded from the request body when present
+    _job_options = ('timeout', 'machine_type', 'disk_size_gb', 'confidential_instance_type', 'priority')
+
+    def _build_form_data(self, workspace_file, creator, jupyter_filename, envs, options=None) -> FormData:
         data = FormData()
         data.add_field('file',
                         value=workspace_file,
@@ -79,6 +82,10 @@ class DataCleanRoomJobHandler(JupyterHandler):
         data.add_field("envs", json.dumps(envs), content_type="application/json")
         data.add_field('creator', creator)
         data.add_field('filename', jupyter_filename)
+        for key in self._job_options:
+            value = (options or {}).get(key)
+            if value:
+                data.add_field(key, str(value))
         return data
 
     async def post_file(self, endpoint, body, workspace_filename, envs, headers) -> str:
@@ -90,12 +97,12 @@ class DataCleanRoomJobHandler(JupyterHandler):
             timeout = aiohttp.ClientTimeout(total=400)
             async with aiohttp.ClientSession(timeout=timeout) as session:
                 with open(workspace_filename, 'rb') as f:
-                    data = self._build_form_data(f, body['creator'], body['filename'], envs)
+                    data = self._build_form_data(f, body['creator'], body['filename'], envs, body)
                     async with session.post(url, data=data, headers=headers, allow_redirects=False) as response:
                         if response.status == HTTPStatus.TEMPORARY_REDIRECT:
                             # when redirect, post manually again
                             with open(workspace_filename, 'rb') as f2:
-                                data = self._build_form_data(f2, body['creator'], body['filename'], envs)
+                                data = self._build_form_data(f2, body['creator'], body['filename'], envs, body)
                                 redirect_url = url_path_join(get_data_clean_room_url(), response.headers['Location']) 
                                 async with session.post(redirect_url, data=data, headers=headers) as redirect_resp:
                                     return await redirect_resp.text()
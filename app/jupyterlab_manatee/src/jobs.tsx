@@ -34,7 +34,9 @@ const statusMap: Map<number, {icon: React.JSX.Element, color: string, text: stri
     [6, {icon: <IconCloseCircle style={{color: 'red', fontSize: 20 }}/>,           color: 'red',   text: 'Executor Killed'}],
     [7, {icon: <IconCloseCircle style={{color: 'red', fontSize: 20 }} />,          color: 'red',   text: 'Executor Failed'}],
     [8, {icon: <IconExclamationCircle style={{color: '#ffcd00', fontSize: 20}} />, color: 'gray',  text: 'Unknown'}],
-    [9, {icon: <IconCloseCircle style={{color: 'red', fontSize: 20 }} />,          color: 'red',   text: 'Launch Failed'}] 
+    [9, {icon: <IconCloseCircle style={{color: 'red', fontSize: 20 }} />,          color: 'red',   text: 'Launch Failed'}],
+    [10, {icon: <IconCloseCircle style={{color: 'red', fontSize: 20 }} />,         color: 'red',   text: 'Timed Out'}],
+    [11, {icon: <IconLoading style={{ fontSize: 20 }} />,                          color: 'gray',  text: 'Queued'}]
 ]);
 
 interface Job {
@@ -43,6 +45,7 @@ interface Job {
     job_status: number;
     created_at: string;
     updated_at: string;
+    failure_reason?: string;
 }
 
 
@@ -284,6 +287,7 @@ const JobTableComponent = (): JSX.Element => {
                         { label: 'Job ID', value: record.id },
                         { label: 'Jupyter File', value: record.jupyter_file_name },
                         { label: 'Job Status', value: <Tag color={color}>{text}</Tag>  },
+                        ...(record.failure_reason ? [{ label: 'Failure Reason', value: record.failure_reason }] : []),
                         { label: 'Created At', value: record.created_at },
                         { label: 'Updated At', value: record.updated_at },
                         { label: 'Download', value: 
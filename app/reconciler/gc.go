@@ -0,0 +1,137 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/manatee-project/manatee/app/api/biz/dal/db"
+	"github.com/manatee-project/manatee/app/api/biz/model/job"
+)
+
+// gcResource is a TEE instance or an image build, which is orphaned once no job in progress refers to it,
+// for example because the job was deleted or the reconciler crashed before recording the instance.
+type gcResource struct {
+	kind      string
+	name      string
+	jobUUID   string
+	createdAt time.Time
+	delete    func(ctx context.Context) error
+}
+
+const (
+	gcInstance   = "instance"
+	gcImageBuild = "image build"
+)
+
+// CollectGarbage deletes the orphaned TEE instances and image builds older than the grace period.
+func (r *ReconcilerImpl) CollectGarbage(ctx context.Context) {
+	resources, err := r.listGCResources(ctx)
+	if err != nil {
+		hlog.Errorf("[GC] failed to list resources: %+v", err)
+		return
+	}
+	uuids := make([]string, 0, len(resources))
+	for _, res := range resources {
+		uuids = append(uuids, res.jobUUID)
+	}
+	var jobs []*db.Job
+	if len(uuids) > 0 {
+		if jobs, err = db.QueryJobsByUUIDs(uuids); err != nil {
+			hlog.Errorf("[GC] failed to query jobs: %+v", err)
+			return
+		}
+	}
+
+	orphans := findOrphans(resources, jobs, time.Now(), r.gc.GracePeriod)
+	deleted := 0
+	for _, res := range orphans {
+		if r.gc.DryRun {
+			hlog.Infof("[GC] dry run: would delete orphaned %s %s of job %s created at %v", res.kind, res.name, res.jobUUID, res.createdAt)
+			continue
+		}
+		deleteCtx, cancel := context.WithTimeout(ctx, r.cfg.JobDeadline)
+		err := res.delete(deleteCtx)
+		cancel()
+		if err != nil {
+			hlog.Errorf("[GC] failed to delete orphaned %s %s: %+v", res.kind, res.name, err)
+			continue
+		}
+		deleted++
+		hlog.Infof("[GC] deleted orphaned %s %s of job %s created at %v", res.kind, res.name, res.jobUUID, res.createdAt)
+	}
+	hlog.Infof("[GC] found %d resources, %d orphaned, %d deleted", len(resources), len(orphans), deleted)
+}
+
+func (r *ReconcilerImpl) listGCResources(ctx context.Context) ([]gcResource, error) {
+	var resources []gcResource
+	instances, err := r.tee.ListInstances(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, instance := range instances {
+		// instances that are not named after a job are not managed by the reconciler
+		if instance.JobUUID == "" {
+			continue
+		}
+		name := instance.Name
+		resources = append(resources, gcResource{
+			kind:      gcInstance,
+			name:      name,
+			jobUUID:   instance.JobUUID,
+			createdAt: instance.CreatedAt,
+			delete: func(ctx context.Context) error {
+				return r.tee.CleanUpInstance(ctx, name)
+			},
+		})
+	}
+
+	builds, err := r.builder.ListBuilds(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, build := range builds {
+		uuid := build.JobUUID
+		resources = append(resources, gcResource{
+			kind:      gcImageBuild,
+			name:      build.Name,
+			jobUUID:   uuid,
+			createdAt: build.CreatedAt,
+			delete: func(ctx context.Context) error {
+				return r.builder.CancelBuild(ctx, uuid)
+			},
+		})
+	}
+	return resources, nil
+}
+
+// findOrphans returns the resources created more than gracePeriod before now that their job does not refer to,
+// including the resources of jobs that no longer exist. The grace period keeps the resources created just before
+// their job is updated.
+func findOrphans(resources []gcResource, jobs []*db.Job, now time.Time, gracePeriod time.Duration) []gcResource {
+	jobsByUUID := make(map[string]*db.Job, len(jobs))
+	for _, j := range jobs {
+		jobsByUUID[j.UUID] = j
+	}
+	var orphans []gcResource
+	for _, res := range resources {
+		if isReferenced(res, jobsByUUID[res.jobUUID]) || now.Sub(res.createdAt) < gracePeriod {
+			continue
+		}
+		orphans = append(orphans, res)
+	}
+	return orphans
+}
+
+func isReferenced(res gcResource, j *db.Job) bool {
+	if j == nil {
+		return false
+	}
+	// finished builds are kept for their logs until the TTL of the kaniko job
+	return isInProgress(j.JobStatus) || (res.kind == gcImageBuild && isBuildFinished(j))
+}
+
+// isBuildFinished reports whether the image of the job was built or failed to build, rather than the build being cancelled.
+func isBuildFinished(j *db.Job) bool {
+	return j.DockerImageDigest != "" || j.JobStatus == int(job.JobStatus_ImageBuildingFailed)
+}
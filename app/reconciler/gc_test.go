@@ -0,0 +1,37 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/common/test/assert"
+	"github.com/manatee-project/manatee/app/api/biz/dal/db"
+	"github.com/manatee-project/manatee/app/api/biz/model/job"
+)
+
+func TestFindOrphans(t *testing.T) {
+	now := time.Now()
+	old := now.Add(-2 * time.Hour)
+	resources := []gcResource{
+		{kind: gcInstance, name: "running", jobUUID: "job1", createdAt: old},
+		{kind: gcInstance, name: "finished", jobUUID: "job2", createdAt: old},
+		{kind: gcInstance, name: "deleted", jobUUID: "job3", createdAt: old},
+		{kind: gcInstance, name: "new", jobUUID: "job3", createdAt: now.Add(-time.Minute)},
+		{kind: gcImageBuild, name: "kaniko-job1", jobUUID: "job1", createdAt: old},
+		{kind: gcImageBuild, name: "kaniko-job4", jobUUID: "job4", createdAt: old},
+		{kind: gcImageBuild, name: "kaniko-job5", jobUUID: "job5", createdAt: old},
+		{kind: gcImageBuild, name: "kaniko-job2", jobUUID: "job2", createdAt: old},
+	}
+	jobs := []*db.Job{
+		{UUID: "job1", JobStatus: int(job.JobStatus_VMRunning)},
+		{UUID: "job2", JobStatus: int(job.JobStatus_VMFinished), DockerImageDigest: "sha256:abc"},
+		{UUID: "job4", JobStatus: int(job.JobStatus_ImageBuildingFailed)},
+		{UUID: "job5", JobStatus: int(job.JobStatus_VMKilled)},
+	}
+
+	var names []string
+	for _, res := range findOrphans(resources, jobs, now, time.Hour) {
+		names = append(names, res.name)
+	}
+	assert.DeepEqual(t, []string{"finished", "deleted", "kaniko-job5"}, names)
+}
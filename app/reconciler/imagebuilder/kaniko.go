@@ -7,32 +7,67 @@ import (
 	"io"
 	"os"
 	"regexp"
+	"strings"
+	"time"
 
 	"github.com/cloudwego/hertz/pkg/common/hlog"
 	"github.com/manatee-project/manatee/app/api/biz/dal/db"
+	"github.com/manatee-project/manatee/app/api/biz/pkg/config"
 	"github.com/pkg/errors"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
+	batchlisters "k8s.io/client-go/listers/batch/v1"
 	"k8s.io/client-go/rest"
 )
 
+// ImageInfo is the result of a finished build. A failed build has no Digest,
+// and FailureReason and FailureDetail describe what went wrong if known.
 type ImageInfo struct {
-	Image  string
-	Digest string
+	Image         string
+	Digest        string
+	FailureReason string
+	FailureDetail string
 }
 
+// JobUUIDLabel labels the Kubernetes jobs created for a job with its UUID.
+const JobUUIDLabel = "manatee.io/job-uuid"
+
+// failureLogLines is the number of trailing build log lines kept as failure detail.
+const failureLogLines = 20
+
 type ImageBuilder interface {
-	BuildImage(*db.Job, string, string) error
-	CheckImageBuilderStatusAndGetInfo(string) (bool, *ImageInfo, error)
+	BuildImage(context.Context, *db.Job, string, string) error
+	CheckImageBuilderStatusAndGetInfo(context.Context, string) (bool, *ImageInfo, error)
+	// CancelBuild stops the image build of the job, or deletes it once finished. It is a no-op if the build no longer exists.
+	CancelBuild(context.Context, string) error
+	// GetBuildLogs streams the build logs of the job, the last tailLines lines if positive.
+	// With follow, the stream stays open until the build finishes.
+	GetBuildLogs(ctx context.Context, uuid string, follow bool, tailLines int64) (io.ReadCloser, error)
+	// ListBuilds returns the image builds that exist, finished or not.
+	ListBuilds(ctx context.Context) ([]Build, error)
+}
+
+// Build is an image build returned by ListBuilds.
+type Build struct {
+	Name      string
+	JobUUID   string
+	CreatedAt time.Time
 }
 
+// ErrBuildNotFound is returned when the build job of the job does not exist.
+var ErrBuildNotFound = errors.New("image build not found")
+
+// ErrBuildLogsNotFound is returned when the build of the job has not started or was already cleaned up.
+var ErrBuildLogsNotFound = errors.New("build logs are not available")
+
 type KanikoImageBuilder struct {
-	ctx       context.Context
-	clientSet *kubernetes.Clientset
+	clientSet kubernetes.Interface
 	namespace string
+	jobLister batchlisters.JobLister
 }
 
 func NewKanikoImageBuilder() (*KanikoImageBuilder, error) {
@@ -52,20 +87,36 @@ func NewKanikoImageBuilder() (*KanikoImageBuilder, error) {
 		return nil, errors.Wrap(err, "failed to get namespace")
 	}
 	namespace := string(RunningNameSpaceByte)
-	ctx := context.Background()
 
 	return &KanikoImageBuilder{
-		ctx:       ctx,
 		clientSet: clientSet,
 		namespace: namespace,
 	}, nil
 }
 
-func (b *KanikoImageBuilder) CheckImageBuilderStatusAndGetInfo(uuid string) (bool, *ImageInfo, error) {
+// SetJobLister makes the builder read the build jobs from an informer cache instead of the API server.
+func (b *KanikoImageBuilder) SetJobLister(lister batchlisters.JobLister) {
+	b.jobLister = lister
+}
+
+func (b *KanikoImageBuilder) getJob(ctx context.Context, name string) (*batchv1.Job, error) {
+	if b.jobLister != nil {
+		// a job created since the last event is not in the cache yet
+		if k8sJob, err := b.jobLister.Jobs(b.namespace).Get(name); err == nil {
+			return k8sJob, nil
+		}
+	}
+	return b.clientSet.BatchV1().Jobs(b.namespace).Get(ctx, name, metav1.GetOptions{})
+}
+
+func (b *KanikoImageBuilder) CheckImageBuilderStatusAndGetInfo(ctx context.Context, uuid string) (bool, *ImageInfo, error) {
 
 	k8sJobName := "kaniko-" + uuid
-	k8sJob, err := b.clientSet.BatchV1().Jobs(b.namespace).Get(b.ctx, k8sJobName, metav1.GetOptions{})
+	k8sJob, err := b.getJob(ctx, k8sJobName)
 	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil, errors.Wrap(ErrBuildNotFound, k8sJobName)
+		}
 		hlog.Errorf("[KanikoJobMonitor]failed to get job: %v", err)
 		return false, nil, errors.Wrap(err, "failed to get job")
 	}
@@ -78,24 +129,25 @@ func (b *KanikoImageBuilder) CheckImageBuilderStatusAndGetInfo(uuid string) (boo
 	hlog.Infof("[KanikoJobMonitor]job name: %v, job status: %v", k8sJob.Name, k8sJob.Status.Conditions[0].Type)
 
 	if k8sJob.Status.Conditions[0].Type == batchv1.JobComplete || k8sJob.Status.Conditions[0].Type == batchv1.JobSuccessCriteriaMet {
-		image, digest, err := b.getImageDigest(k8sJob.Name)
+		image, digest, err := b.getImageDigest(ctx, k8sJob.Name)
 		if err != nil {
 			hlog.Errorf("[KanikoJobMonitor] failed to get image digest: %+v", err)
 			return false, nil, err
 		}
 		hlog.Infof("Image build done: %s@sha256:%s", image, digest)
 
-		b.deleteJob(k8sJob.Name)
-
+		// the job is kept for its logs until its TTL
 		return true, &ImageInfo{Image: image, Digest: digest}, nil
 	} else if k8sJob.Status.Conditions[0].Type == batchv1.JobFailed || k8sJob.Status.Conditions[0].Type == batchv1.JobFailureTarget {
-		return true, nil, nil
+		reason, detail := b.getBuildFailure(ctx, k8sJob)
+		hlog.Infof("[KanikoJobMonitor]job %v failed: %s", k8sJob.Name, reason)
+		return true, &ImageInfo{FailureReason: reason, FailureDetail: detail}, nil
 	}
 	return false, nil, nil
 }
 
-func (b *KanikoImageBuilder) getImageDigest(jobName string) (string, string, error) {
-	pods, err := b.clientSet.CoreV1().Pods(b.namespace).List(b.ctx, metav1.ListOptions{
+func (b *KanikoImageBuilder) getImageDigest(ctx context.Context, jobName string) (string, string, error) {
+	pods, err := b.clientSet.CoreV1().Pods(b.namespace).List(ctx, metav1.ListOptions{
 		LabelSelector: "job-name=" + jobName,
 	})
 	if err != nil {
@@ -104,7 +156,7 @@ func (b *KanikoImageBuilder) getImageDigest(jobName string) (string, string, err
 	hlog.Infof("[KanikoJobMonitor] pods num: %d", len(pods.Items))
 	for _, pod := range pods.Items {
 		req := b.clientSet.CoreV1().Pods(b.namespace).GetLogs(pod.Name, &corev1.PodLogOptions{})
-		logs, err := req.Stream(b.ctx)
+		logs, err := req.Stream(ctx)
 		if err != nil {
 			return "", "", errors.Wrap(err, "failed to read log stream")
 		}
@@ -145,10 +197,63 @@ func (b *KanikoImageBuilder) getImageAndDigestFromLog(reader io.Reader) (string,
 
 	return matches[1] + "@sha256:" + matches[2], matches[2], nil
 }
-func (b *KanikoImageBuilder) deleteJob(name string) error {
+
+// getBuildFailure reads the failure of a kaniko job from the tail of its pod logs,
+// falling back to the job condition if the logs are not available.
+func (b *KanikoImageBuilder) getBuildFailure(ctx context.Context, k8sJob *batchv1.Job) (string, string) {
+	reason := fmt.Sprintf("Kaniko: %s", k8sJob.Status.Conditions[0].Message)
+	pods, err := b.clientSet.CoreV1().Pods(b.namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: "job-name=" + k8sJob.Name,
+	})
+	if err != nil {
+		hlog.Errorf("[KanikoJobMonitor]failed to list pod: %v", err)
+		return reason, ""
+	}
+	tailLines := int64(failureLogLines)
+	for _, pod := range pods.Items {
+		req := b.clientSet.CoreV1().Pods(b.namespace).GetLogs(pod.Name, &corev1.PodLogOptions{TailLines: &tailLines})
+		logs, err := req.Stream(ctx)
+		if err != nil {
+			hlog.Errorf("[KanikoJobMonitor]failed to read log stream: %v", err)
+			continue
+		}
+		logReason, detail := getFailureFromLog(logs)
+		_ = logs.Close()
+		if logReason != "" {
+			return logReason, detail
+		}
+	}
+	return reason, ""
+}
+
+// kanikoLogPrefix matches the level and timestamp kaniko prepends to its log lines, e.g. "ERROR[0003] ".
+var kanikoLogPrefix = regexp.MustCompile(`^[A-Z]+\[\d+\]\s*`)
+
+// getFailureFromLog returns the last non-empty log line as the failure reason and the last lines as the detail.
+func getFailureFromLog(reader io.Reader) (string, string) {
+	var lines []string
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		lines = append(lines, line)
+		if len(lines) > failureLogLines {
+			lines = lines[1:]
+		}
+	}
+	if len(lines) == 0 {
+		return "", ""
+	}
+	lastLine := kanikoLogPrefix.ReplaceAllString(lines[len(lines)-1], "")
+	return "Kaniko: " + lastLine, strings.Join(lines, "\n")
+}
+
+func (b *KanikoImageBuilder) deleteJob(ctx context.Context, name string) error {
 	// hlog.Infof("[KanikoJobMonitor]delete job: %v", name)
 	deletePolicy := metav1.DeletePropagationForeground
-	if err := b.clientSet.BatchV1().Jobs(b.namespace).Delete(b.ctx, name, metav1.DeleteOptions{
+	if err := b.clientSet.BatchV1().Jobs(b.namespace).Delete(ctx, name, metav1.DeleteOptions{
 		PropagationPolicy: &deletePolicy,
 	}); err != nil {
 		return errors.Wrap(err, "failed to delete job")
@@ -156,7 +261,67 @@ func (b *KanikoImageBuilder) deleteJob(name string) error {
 	return nil
 }
 
-func (b *KanikoImageBuilder) BuildImage(j *db.Job, baseImage string, image string) error {
+func (b *KanikoImageBuilder) CancelBuild(ctx context.Context, uuid string) error {
+	err := b.deleteJob(ctx, "kaniko-"+uuid)
+	if err != nil && !apierrors.IsNotFound(errors.Cause(err)) {
+		return err
+	}
+	return nil
+}
+
+func (b *KanikoImageBuilder) ListBuilds(ctx context.Context) ([]Build, error) {
+	// builds created before they were labelled are only recognized by name
+	k8sJobs, err := b.clientSet.BatchV1().Jobs(b.namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list jobs")
+	}
+	var builds []Build
+	for _, k8sJob := range k8sJobs.Items {
+		uuid, ok := strings.CutPrefix(k8sJob.Name, "kaniko-")
+		if !ok {
+			continue
+		}
+		builds = append(builds, Build{
+			Name:      k8sJob.Name,
+			JobUUID:   uuid,
+			CreatedAt: k8sJob.CreationTimestamp.Time,
+		})
+	}
+	return builds, nil
+}
+
+func (b *KanikoImageBuilder) GetBuildLogs(ctx context.Context, uuid string, follow bool, tailLines int64) (io.ReadCloser, error) {
+	pods, err := b.clientSet.CoreV1().Pods(b.namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: "job-name=kaniko-" + uuid,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list pod")
+	}
+	if len(pods.Items) == 0 {
+		return nil, ErrBuildLogsNotFound
+	}
+	// kaniko jobs are not retried, but take the latest pod in case it was recreated
+	pod := pods.Items[0]
+	for _, p := range pods.Items[1:] {
+		if p.CreationTimestamp.After(pod.CreationTimestamp.Time) {
+			pod = p
+		}
+	}
+	opts := &corev1.PodLogOptions{
+		Container: "kaniko",
+		Follow:    follow,
+	}
+	if tailLines > 0 {
+		opts.TailLines = &tailLines
+	}
+	logs, err := b.clientSet.CoreV1().Pods(b.namespace).GetLogs(pod.Name, opts).Stream(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read log stream")
+	}
+	return logs, nil
+}
+
+func (b *KanikoImageBuilder) BuildImage(ctx context.Context, j *db.Job, baseImage string, image string) error {
 
 	buildArgs := []string{
 		fmt.Sprintf("--context=%s", j.BuildContextPath),
@@ -166,21 +331,27 @@ func (b *KanikoImageBuilder) BuildImage(j *db.Job, baseImage string, image strin
 		fmt.Sprintf("--build-arg=USER_WORKSPACE=%s", fmt.Sprintf("%s-workspace", j.Creator)),
 		fmt.Sprintf("--build-arg=BASE_IMAGE=%s", baseImage),
 		fmt.Sprintf("--build-arg=CUSTOMTOKEN_SIGNED_URL=%s", j.CustomTokenPutSignedUrl),
+		fmt.Sprintf("--build-arg=MANIFEST_SIGNED_URL=%s", j.ManifestPutSignedUrl),
+		fmt.Sprintf("--build-arg=JOB_UUID=%s", j.UUID),
+		fmt.Sprintf("--build-arg=WORKSPACE_HASH=%s", j.WorkspaceHash),
+		fmt.Sprintf("--build-arg=ATTESTATION_AUDIENCE=%s", j.AttestationAudience),
+		fmt.Sprintf("--build-arg=ATTESTATION_TOKEN_TYPE=%s", j.AttestationTokenType),
 	}
 	var envs []corev1.EnvVar
 
-	if os.Getenv("STORAGE_TYPE") == "MINIO" {
+	cfg := config.Get()
+	if cfg.StorageType == "MINIO" {
 		envs = append(envs, corev1.EnvVar{
 			Name:  "AWS_ACCESS_KEY_ID",
-			Value: os.Getenv("AWS_ACCESS_KEY_ID"),
+			Value: cfg.Minio.AccessKey,
 		},
 			corev1.EnvVar{
 				Name:  "AWS_SECRET_ACCESS_KEY",
-				Value: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+				Value: cfg.Minio.SecretKey,
 			},
 			corev1.EnvVar{
 				Name:  "S3_ENDPOINT",
-				Value: fmt.Sprintf("http://%s", os.Getenv("S3_ENDPOINT")),
+				Value: fmt.Sprintf("http://%s", cfg.Minio.Endpoint),
 			},
 			corev1.EnvVar{
 				Name:  "AWS_REGION",
@@ -193,14 +364,14 @@ func (b *KanikoImageBuilder) BuildImage(j *db.Job, baseImage string, image strin
 		)
 	}
 	kanikoJobName := fmt.Sprintf("kaniko-%s", j.UUID)
-	err := b.createBuildJob(kanikoJobName, buildArgs, envs)
+	err := b.createBuildJob(ctx, kanikoJobName, j.UUID, buildArgs, envs)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func (b *KanikoImageBuilder) createBuildJob(jobName string, buildArgs []string, envs []corev1.EnvVar) error {
+func (b *KanikoImageBuilder) createBuildJob(ctx context.Context, jobName string, uuid string, buildArgs []string, envs []corev1.EnvVar) error {
 	memQuantity, err := resource.ParseQuantity("6000M")
 	if err != nil {
 		return errors.Wrap(err, "failed to parse mem quantity")
@@ -211,6 +382,9 @@ func (b *KanikoImageBuilder) createBuildJob(jobName string, buildArgs []string,
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      jobName,
 			Namespace: b.namespace,
+			Labels: map[string]string{
+				JobUUIDLabel: uuid,
+			},
 		},
 		Spec: batchv1.JobSpec{
 			TTLSecondsAfterFinished: &ttlSecondsAfterFinished,
@@ -241,7 +415,11 @@ func (b *KanikoImageBuilder) createBuildJob(jobName string, buildArgs []string,
 			},
 		},
 	}
-	_, err = b.clientSet.BatchV1().Jobs(b.namespace).Create(b.ctx, kanikoJob, metav1.CreateOptions{})
+	_, err = b.clientSet.BatchV1().Jobs(b.namespace).Create(ctx, kanikoJob, metav1.CreateOptions{})
+	if apierrors.IsAlreadyExists(err) {
+		// the build was started by an earlier attempt
+		return nil
+	}
 	if err != nil {
 		return errors.Wrap(err, "failed to create kubernetes job")
 	}
@@ -2,8 +2,13 @@ package imagebuilder
 
 import (
 	"bufio"
+	"context"
 	"strings"
 	"testing"
+
+	"github.com/manatee-project/manatee/app/api/biz/dal/db"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
 )
 
 func TestGetImageAndDigestFromLog(t *testing.T) {
@@ -32,3 +37,41 @@ func TestGetImageAndDigestFromLog(t *testing.T) {
 		t.Errorf("Expected digest %v, but got %v", expectedDigest, digest)
 	}
 }
+
+func TestGetFailureFromLog(t *testing.T) {
+	logs := `INFO[0001] Retrieving image manifest jupyter/base-notebook
+INFO[0002] Unpacking rootfs as cmd COPY requires it.
+
+ERROR[0003] error building image: error building stage: failed to get files used from context: failed to get fileinfo for /kaniko/buildcontext/user1-workspace/data.csv: lstat /kaniko/buildcontext/user1-workspace/data.csv: no such file or directory
+`
+	reason, detail := getFailureFromLog(strings.NewReader(logs))
+	expectedReason := "Kaniko: error building image: error building stage: failed to get files used from context: failed to get fileinfo for /kaniko/buildcontext/user1-workspace/data.csv: lstat /kaniko/buildcontext/user1-workspace/data.csv: no such file or directory"
+	if reason != expectedReason {
+		t.Errorf("Expected reason %v, but got %v", expectedReason, reason)
+	}
+	if len(strings.Split(detail, "\n")) != 3 {
+		t.Errorf("Expected 3 lines of detail, but got %v", detail)
+	}
+
+	reason, detail = getFailureFromLog(strings.NewReader(""))
+	if reason != "" || detail != "" {
+		t.Errorf("Expected no failure from empty log, but got %v, %v", reason, detail)
+	}
+}
+
+func TestBuildImageIsIdempotent(t *testing.T) {
+	b := &KanikoImageBuilder{clientSet: fake.NewSimpleClientset(), namespace: "default"}
+	j := &db.Job{UUID: "job1"}
+	for i := 0; i < 2; i++ {
+		if err := b.BuildImage(context.Background(), j, "base", "image"); err != nil {
+			t.Fatalf("expected build %d to start, got %v", i, err)
+		}
+	}
+	jobs, err := b.clientSet.BatchV1().Jobs("default").List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(jobs.Items) != 1 {
+		t.Errorf("expected a single build job, got %d", len(jobs.Items))
+	}
+}
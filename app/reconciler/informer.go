@@ -0,0 +1,86 @@
+package main
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/manatee-project/manatee/app/reconciler/imagebuilder"
+	"github.com/pkg/errors"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	batchlisters "k8s.io/client-go/listers/batch/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+)
+
+// jobInformer watches the Kubernetes jobs labelled with a job UUID,
+// which are the image builds and the instances of the mock TEE backend.
+type jobInformer struct {
+	factory  informers.SharedInformerFactory
+	informer cache.SharedIndexInformer
+	lister   batchlisters.JobLister
+}
+
+func newJobInformer(resync time.Duration) (*jobInformer, error) {
+	clusterConfig, err := rest.InClusterConfig()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to init cluster config")
+	}
+	clientSet, err := kubernetes.NewForConfig(clusterConfig)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to init client set")
+	}
+	namespace, err := os.ReadFile("/var/run/secrets/kubernetes.io/serviceaccount/namespace")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get namespace")
+	}
+
+	factory := informers.NewSharedInformerFactoryWithOptions(clientSet, resync,
+		informers.WithNamespace(string(namespace)),
+		informers.WithTweakListOptions(func(options *metav1.ListOptions) {
+			options.LabelSelector = imagebuilder.JobUUIDLabel
+		}),
+	)
+	jobs := factory.Batch().V1().Jobs()
+	return &jobInformer{
+		factory:  factory,
+		informer: jobs.Informer(),
+		lister:   jobs.Lister(),
+	}, nil
+}
+
+// Run calls enqueue with the job UUID whenever a Kubernetes job changes, and for every Kubernetes job on resync.
+// It returns once the cache is synced, the informer stops when ctx is done.
+func (i *jobInformer) Run(ctx context.Context, enqueue func(uuid string)) error {
+	handle := func(obj interface{}) {
+		if uuid := jobUUID(obj); uuid != "" {
+			enqueue(uuid)
+		}
+	}
+	_, err := i.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    handle,
+		UpdateFunc: func(_, obj interface{}) { handle(obj) },
+		DeleteFunc: handle,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to add event handler")
+	}
+	i.factory.Start(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), i.informer.HasSynced) {
+		return errors.New("failed to sync job informer")
+	}
+	return nil
+}
+
+func jobUUID(obj interface{}) string {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	if k8sJob, ok := obj.(*batchv1.Job); ok {
+		return k8sJob.Labels[imagebuilder.JobUUIDLabel]
+	}
+	return ""
+}
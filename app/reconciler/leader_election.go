@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"os"
+	"sync/atomic"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/manatee-project/manatee/app/api/biz/pkg/config"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+)
+
+// runWithLeaderElection calls run once this replica holds the lease, and returns when ctx is done or the lease is lost.
+// The context passed to run is cancelled as soon as either happens. Once ctx is done, the lease is still renewed
+// until run has returned and only then released, so that no other replica reconciles concurrently.
+// Once the lease is lost, it returns without waiting for run, and the caller is expected to exit.
+func runWithLeaderElection(ctx context.Context, cfg config.LeaderElectionConfig, run func(ctx context.Context)) error {
+	clusterConfig, err := rest.InClusterConfig()
+	if err != nil {
+		return errors.Wrap(err, "failed to init cluster config")
+	}
+	clientSet, err := kubernetes.NewForConfig(clusterConfig)
+	if err != nil {
+		return errors.Wrap(err, "failed to init client set")
+	}
+	namespace, err := os.ReadFile("/var/run/secrets/kubernetes.io/serviceaccount/namespace")
+	if err != nil {
+		return errors.Wrap(err, "failed to get namespace")
+	}
+	// the pod name
+	identity, err := os.Hostname()
+	if err != nil {
+		return errors.Wrap(err, "failed to get hostname")
+	}
+
+	lock := &resourcelock.LeaseLock{
+		LeaseMeta: metav1.ObjectMeta{
+			Name:      cfg.LeaseName,
+			Namespace: string(namespace),
+		},
+		Client: clientSet.CoordinationV1(),
+		LockConfig: resourcelock.ResourceLockConfig{
+			Identity: identity,
+		},
+	}
+	// the election outlives ctx until run returns
+	electionCtx, cancelElection := context.WithCancel(context.Background())
+	defer cancelElection()
+	var leading atomic.Bool
+	stop := context.AfterFunc(ctx, func() {
+		if !leading.Load() {
+			cancelElection()
+		}
+	})
+	defer stop()
+
+	elector, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+		Lock:            lock,
+		LeaseDuration:   cfg.LeaseDuration,
+		RenewDeadline:   cfg.RenewDeadline,
+		RetryPeriod:     cfg.RetryPeriod,
+		ReleaseOnCancel: true,
+		Name:            cfg.LeaseName,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(leaderCtx context.Context) {
+				leading.Store(true)
+				defer cancelElection()
+				hlog.Infof("[Reconciler] %s started leading", identity)
+				runCtx, cancel := context.WithCancel(leaderCtx)
+				defer cancel()
+				stop := context.AfterFunc(ctx, cancel)
+				defer stop()
+				run(runCtx)
+			},
+			OnStoppedLeading: func() {
+				hlog.Infof("[Reconciler] %s stopped leading", identity)
+			},
+			OnNewLeader: func(leader string) {
+				if leader != identity {
+					hlog.Infof("[Reconciler] %s is the leader", leader)
+				}
+			},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to create leader elector")
+	}
+	elector.Run(electionCtx)
+	return nil
+}
@@ -2,24 +2,85 @@ package main
 
 import (
 	"context"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
 	"time"
 
 	"github.com/cloudwego/hertz/pkg/common/hlog"
 	"github.com/manatee-project/manatee/app/api/biz/dal/db"
+	"github.com/manatee-project/manatee/app/api/biz/pkg/config"
 )
 
 func main() {
+	// the signal only stops the loop, jobs being reconciled are finished within their deadline
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, os.Interrupt)
+	defer stop()
 
-	ctx := context.Background()
-
+	config.Init()
 	db.Init()
 
-	reconciler := NewReconciler(ctx)
+	reconciler := NewReconciler(context.Background())
+
+	cfg := config.Get().LeaderElection
+	if !cfg.Enabled {
+		run(ctx, reconciler)
+		return
+	}
+	if err := runWithLeaderElection(ctx, cfg, func(ctx context.Context) {
+		run(ctx, reconciler)
+	}); err != nil {
+		hlog.Fatalf("[Reconciler] leader election failed: %v", err)
+	}
+	if ctx.Err() == nil {
+		// leadership was lost and the jobs in progress may still be reconciled by run,
+		// exit without waiting for them so that the replica restarts as a follower
+		hlog.Fatalf("[Reconciler] lost leadership")
+	}
+}
+
+// run reconciles the jobs on changes of their Kubernetes jobs and periodically until ctx is done,
+// and returns once the jobs in progress are reconciled.
+func run(ctx context.Context, reconciler Reconciler) {
+	reconciler.Start(ctx)
+	defer reconciler.Wait()
 
+	if gc := config.Get().GC; gc.Enabled {
+		var wg sync.WaitGroup
+		defer wg.Wait()
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			collectGarbage(ctx, reconciler, gc.Interval)
+		}()
+	}
+
+	ticker := time.NewTicker(config.Get().Reconciler.ResyncPeriod)
+	defer ticker.Stop()
 	for {
 		hlog.Info("Reconciling...")
 		reconciler.Reconcile(ctx)
 
-		time.Sleep(10 * time.Second)
+		select {
+		case <-ctx.Done():
+			hlog.Info("[Reconciler] stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// collectGarbage deletes the orphaned resources periodically until ctx is done.
+func collectGarbage(ctx context.Context, reconciler Reconciler, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			reconciler.CollectGarbage(ctx)
+		}
 	}
 }
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/manatee-project/manatee/app/api/biz/dal/db"
+	"github.com/manatee-project/manatee/app/api/biz/model/job"
+	"github.com/manatee-project/manatee/app/api/biz/pkg/config"
+	"github.com/manatee-project/manatee/app/api/biz/pkg/storage"
+)
+
+// admitQueuedJobs starts the queued jobs that fit in the quotas, in the order of the scheduler. It is called on every
+// pass and whenever a job finishes, and is serialized so that two calls never admit jobs for the same capacity.
+func (r *ReconcilerImpl) admitQueuedJobs() {
+	r.admitMu.Lock()
+	defer r.admitMu.Unlock()
+
+	jobs, err := db.GetAllInProgressJobs()
+	if err != nil {
+		hlog.Errorf("[Reconciler] failed to get jobs to admit: %+v", err)
+		return
+	}
+	if !slices.ContainsFunc(jobs, func(j *db.Job) bool { return j.JobStatus == int(job.JobStatus_Queued) }) {
+		return
+	}
+	usage, err := recentVMUsage(time.Now(), r.sched.FairShareWindow)
+	if err != nil {
+		// the jobs are still admitted by priority
+		hlog.Errorf("[Reconciler] failed to get recent VM usage: %+v", err)
+	}
+	for _, j := range admitJobs(jobs, r.quota, usage) {
+		if err := r.issueSignedUrls(j); err != nil {
+			hlog.Errorf("[Reconciler] failed to admit job %s: %+v", j.UUID, err)
+			continue
+		}
+		setJobStatus(j, job.JobStatus_Created, "job is admitted within the quotas")
+		if err := db.UpdateJob(j, int(job.JobStatus_Queued)); err != nil {
+			if errors.Is(err, db.ErrJobStatusConflict) {
+				hlog.Infof("[Reconciler] skipping admission of job %s: %v", j.UUID, err)
+			} else {
+				hlog.Errorf("[Reconciler] failed to admit job %s: %+v", j.UUID, err)
+			}
+			continue
+		}
+		hlog.Infof("[Reconciler] admitted job %s of %s with priority %d", j.UUID, j.Creator, j.Priority)
+		r.queue.Add(j.UUID)
+	}
+}
+
+// issueSignedUrls issues the signed URLs the job uploads its output, attestation token and manifest with. They are
+// issued on admission, so that they last for the timeout of the job.
+func (r *ReconcilerImpl) issueSignedUrls(j *db.Job) error {
+	if r.storage == nil {
+		return fmt.Errorf("no storage to issue signed URLs")
+	}
+	timeout := getJobTimeout(j)
+	var err error
+	j.OutputPutSignedUrl, err = r.storage.IssueSignedUrl(storage.JobOutputPath(j.Creator, j.UUID, j.JupyterFileName), "PUT", timeout)
+	if err != nil {
+		return fmt.Errorf("failed to issue signed URL for the output: %w", err)
+	}
+	j.CustomTokenPutSignedUrl, err = r.storage.IssueSignedUrl(storage.JobTokenPath(j.Creator, j.UUID), "PUT", timeout)
+	if err != nil {
+		return fmt.Errorf("failed to issue signed URL for the token: %w", err)
+	}
+	j.ManifestPutSignedUrl, err = r.storage.IssueSignedUrl(storage.JobManifestPath(j.Creator, j.UUID), "PUT", timeout)
+	if err != nil {
+		return fmt.Errorf("failed to issue signed URL for the manifest: %w", err)
+	}
+	return nil
+}
+
+// admitJobs returns the queued jobs among jobs that fit in the quotas together with the other jobs in progress,
+// in the order they are admitted. The next job admitted is the one with the highest priority, then of the creator
+// with the least VM usage, then of the creator with the fewest jobs in progress, then the first in jobs.
+// A job that does not fit does not hold back the jobs of other users and groups.
+func admitJobs(jobs []*db.Job, quota config.QuotaConfig, usage map[string]time.Duration) []*db.Job {
+	var total int
+	users := make(map[string]int)
+	groups := make(map[string]int)
+	count := func(j *db.Job) {
+		total++
+		users[j.Creator]++
+		for _, g := range j.Groups {
+			groups[g]++
+		}
+	}
+	fits := func(j *db.Job) bool {
+		if quota.MaxJobs > 0 && total >= quota.MaxJobs {
+			return false
+		}
+		if quota.MaxJobsPerUser > 0 && users[j.Creator] >= quota.MaxJobsPerUser {
+			return false
+		}
+		for _, g := range j.Groups {
+			if limit := quota.GroupLimit(g); limit > 0 && groups[g] >= limit {
+				return false
+			}
+		}
+		return true
+	}
+	before := func(a, b *db.Job) bool {
+		if a.Priority != b.Priority {
+			return a.Priority > b.Priority
+		}
+		if usage[a.Creator] != usage[b.Creator] {
+			return usage[a.Creator] < usage[b.Creator]
+		}
+		return users[a.Creator] < users[b.Creator]
+	}
+
+	var queued []*db.Job
+	for _, j := range jobs {
+		if j.JobStatus == int(job.JobStatus_Queued) {
+			// cancelled jobs are killed without being started
+			if !j.CancelRequested {
+				queued = append(queued, j)
+			}
+		} else if isInProgress(j.JobStatus) {
+			count(j)
+		}
+	}
+	var admitted []*db.Job
+	for {
+		next := -1
+		for i, j := range queued {
+			if fits(j) && (next < 0 || before(j, queued[next])) {
+				next = i
+			}
+		}
+		if next < 0 {
+			return admitted
+		}
+		count(queued[next])
+		admitted = append(admitted, queued[next])
+		queued = slices.Delete(queued, next, next+1)
+	}
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/cloudwego/hertz/pkg/common/test/assert"
+	"github.com/manatee-project/manatee/app/api/biz/dal/db"
+	"github.com/manatee-project/manatee/app/api/biz/model/job"
+	"github.com/manatee-project/manatee/app/api/biz/pkg/config"
+)
+
+func TestAdmitJobs(t *testing.T) {
+	running := int(job.JobStatus_VMRunning)
+	queued := int(job.JobStatus_Queued)
+	jobs := []*db.Job{
+		{UUID: "job1", Creator: "alice", Groups: []string{"research"}, JobStatus: running},
+		{UUID: "job2", Creator: "bob", Groups: []string{"research"}, JobStatus: int(job.JobStatus_VMFinished)},
+		{UUID: "job3", Creator: "alice", Groups: []string{"research"}, JobStatus: queued},
+		{UUID: "job4", Creator: "alice", JobStatus: queued},
+		{UUID: "job5", Creator: "bob", Groups: []string{"research"}, JobStatus: queued},
+		{UUID: "job6", Creator: "carol", Groups: []string{"finance"}, JobStatus: queued, CancelRequested: true},
+		{UUID: "job7", Creator: "carol", Groups: []string{"finance"}, JobStatus: queued},
+		{UUID: "job8", Creator: "dave", Groups: []string{"ops"}, JobStatus: queued},
+	}
+	admitted := func(quota config.QuotaConfig) []string {
+		var uuids []string
+		for _, j := range admitJobs(jobs, quota, nil) {
+			uuids = append(uuids, j.UUID)
+		}
+		// the order is tested in TestAdmitJobsOrder
+		slices.Sort(uuids)
+		return uuids
+	}
+
+	assert.DeepEqual(t, []string{"job3", "job4", "job5", "job7", "job8"}, admitted(config.QuotaConfig{}))
+	assert.DeepEqual(t, []string{"job3", "job5", "job7", "job8"}, admitted(config.QuotaConfig{MaxJobsPerUser: 2}))
+	// job3 and job5 wait for the job of alice in research to finish
+	assert.DeepEqual(t, []string{"job4", "job7", "job8"}, admitted(config.QuotaConfig{MaxJobsPerGroup: 1}))
+	// the last slot of research goes to bob, who has no job in progress
+	assert.DeepEqual(t, []string{"job4", "job5", "job7", "job8"}, admitted(config.QuotaConfig{
+		MaxJobsPerGroup: 1,
+		GroupLimits:     map[string]int{"research": 2},
+	}))
+	// the two free slots go to the first users without jobs in progress
+	assert.DeepEqual(t, []string{"job5", "job7"}, admitted(config.QuotaConfig{MaxJobs: 3}))
+}
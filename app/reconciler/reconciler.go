@@ -2,49 +2,70 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"os"
+	"sync"
 	"time"
 
 	"github.com/cloudwego/hertz/pkg/common/hlog"
 	"github.com/manatee-project/manatee/app/api/biz/dal/db"
 	"github.com/manatee-project/manatee/app/api/biz/model/job"
+	"github.com/manatee-project/manatee/app/api/biz/pkg/config"
+	"github.com/manatee-project/manatee/app/api/biz/pkg/storage"
 	"github.com/manatee-project/manatee/app/reconciler/imagebuilder"
 	"github.com/manatee-project/manatee/app/reconciler/registry"
 	"github.com/manatee-project/manatee/app/reconciler/tee_backend"
+	batchlisters "k8s.io/client-go/listers/batch/v1"
 )
 
+// defaultJobTimeout applies to jobs submitted without a timeout.
+const defaultJobTimeout = 6 * time.Hour
+
 type Reconciler interface {
+	// Start starts the workers reconciling the jobs, they stop once ctx is done.
+	Start(ctx context.Context)
+	// Reconcile admits the queued jobs that fit in the quotas, and queues all jobs in progress,
+	// as a fallback to the events of the Kubernetes jobs.
 	Reconcile(ctx context.Context)
+	// Wait waits for the workers to stop.
+	Wait()
+	// CollectGarbage deletes the TEE instances and image builds no job refers to.
+	CollectGarbage(ctx context.Context)
 }
 
 type ReconcilerImpl struct {
-	tee     tee_backend.TEEProvider
-	builder imagebuilder.ImageBuilder
-	ctx     context.Context
+	tee      tee_backend.TEEProvider
+	builder  imagebuilder.ImageBuilder
+	storage  storage.Storage
+	queue    *jobQueue
+	informer *jobInformer
+	cfg      config.ReconcilerConfig
+	gc       config.GCConfig
+	quota    config.QuotaConfig
+	sched    config.SchedulerConfig
+	admitMu  sync.Mutex
+}
+
+// jobListerSetter is implemented by the image builders and TEE backends that run Kubernetes jobs.
+type jobListerSetter interface {
+	SetJobLister(lister batchlisters.JobLister)
 }
 
 func NewReconciler(ctx context.Context) *ReconcilerImpl {
-	// FIXME: get config to determine which TEE provider to use.
-	// for now, we read the config from ens
 	var tee tee_backend.TEEProvider
 	var err error
-	teeType := os.Getenv("TEE_BACKEND")
-	if teeType == "MOCK" {
-		tee, err = tee_backend.NewMockTeeBackend(ctx)
-		if err != nil {
-			hlog.Errorf("failed to init TEE provider %+v", err)
-		}
-	} else if teeType == "GCP" {
-		tee, err = tee_backend.NewTEEProviderGCPConfidentialSpace(ctx)
-		if err != nil {
-			hlog.Errorf("failed to init TEE provider %+v", err)
-		}
+	cfg := config.Get()
+	if cfg.TEEBackend == "GCP" {
+		tee, err = tee_backend.NewTEEProviderGCPConfidentialSpace(ctx, cfg)
 	} else {
-		tee, err = tee_backend.NewMockTeeBackend(ctx)
-		if err != nil {
-			hlog.Errorf("failed to init TEE provider %+v", err)
-		}
+		tee, err = tee_backend.NewMockTeeBackend(cfg)
+	}
+	// the clients that initialized, to share the informer cache with
+	var clients []any
+	if err != nil {
+		hlog.Errorf("failed to init TEE provider %+v", err)
+	} else {
+		clients = append(clients, tee)
 	}
 
 	// FIXME: get config to determine which ImageBuilder to use.
@@ -52,16 +73,58 @@ func NewReconciler(ctx context.Context) *ReconcilerImpl {
 	builder, err := imagebuilder.NewKanikoImageBuilder()
 	if err != nil {
 		hlog.Errorf("failed to init image builder %+v", err)
+	} else {
+		clients = append(clients, builder)
+	}
+
+	// without the storage, no signed URLs are issued for the jobs and none is admitted
+	storage, err := storage.GetStorage(ctx)
+	if err != nil {
+		hlog.Errorf("failed to init storage %+v", err)
 	}
 
-	return &ReconcilerImpl{
-		tee:     tee,
-		builder: builder,
-		ctx:     ctx,
+	// without the informer, the jobs are only reconciled by the periodic passes
+	informer, err := newJobInformer(cfg.Reconciler.ResyncPeriod)
+	if err != nil {
+		hlog.Errorf("failed to init job informer %+v", err)
+	} else {
+		for _, c := range clients {
+			if s, ok := c.(jobListerSetter); ok {
+				s.SetJobLister(informer.lister)
+			}
+		}
 	}
+
+	r := &ReconcilerImpl{
+		tee:      tee,
+		builder:  builder,
+		storage:  storage,
+		informer: informer,
+		cfg:      cfg.Reconciler,
+		gc:       cfg.GC,
+		quota:    cfg.Quota,
+		sched:    cfg.Scheduler,
+	}
+	r.queue = newJobQueue(cfg.Reconciler.Workers, cfg.Reconciler.JobDeadline, r.reconcileJob)
+	return r
+}
+
+func (r *ReconcilerImpl) Start(ctx context.Context) {
+	r.queue.Start(ctx)
+	if r.informer != nil {
+		if err := r.informer.Run(ctx, r.queue.Add); err != nil {
+			hlog.Errorf("[Reconciler] failed to run job informer: %+v", err)
+		}
+	}
+}
+
+func (r *ReconcilerImpl) Wait() {
+	r.queue.Wait()
 }
 
 func (r *ReconcilerImpl) Reconcile(ctx context.Context) {
+	r.admitQueuedJobs()
+
 	jobs, err := db.GetAllInProgressJobs()
 	if err != nil {
 		hlog.Errorf("[Reconciler] failed to get all in progress jobs: %v", err)
@@ -71,97 +134,328 @@ func (r *ReconcilerImpl) Reconcile(ctx context.Context) {
 	// debug log
 	hlog.Debugf("[Reconciler] found %d jobs in progress", len(jobs))
 	for _, j := range jobs {
-		// debug log
-		hlog.Debugf("[Reconciler] job %s in status %d", j.UUID, j.JobStatus)
-		err := r.updateJobStatus(j)
-		if err != nil {
-			hlog.Errorf("[Reconciler] failed to reconcile job %s: %+v", j.UUID, err)
-			continue
-		}
-		db.UpdateJob(j)
+		r.queue.Add(j.UUID)
+	}
+}
 
-		// clean up instance if necessary
-		if j.JobStatus == int(job.JobStatus_VMFinished) || j.JobStatus == int(job.JobStatus_VMFailed) {
-			r.tee.CleanUpInstance(j.InstanceName)
+// reconcileJob moves the job to its next status. ctx bounds the calls to the TEE backend and the image builder.
+func (r *ReconcilerImpl) reconcileJob(ctx context.Context, uuid string) {
+	j, err := db.QueryJobByUUID(uuid)
+	if err != nil {
+		hlog.Errorf("[Reconciler] failed to get job %s: %+v", uuid, err)
+		return
+	}
+	if !isInProgress(j.JobStatus) {
+		return
+	}
+	// a cancelled job is cleaned up without waiting for the backoff
+	if j.NextAttemptAt != nil && time.Now().Before(*j.NextAttemptAt) && !j.CancelRequested {
+		hlog.Debugf("[Reconciler] job %s is retried at %v", j.UUID, *j.NextAttemptAt)
+		return
+	}
+	// debug log
+	hlog.Debugf("[Reconciler] job %s in status %d", j.UUID, j.JobStatus)
+	oldStatus := j.JobStatus
+	err = r.updateJobStatus(ctx, j)
+	if err != nil {
+		hlog.Errorf("[Reconciler] failed to reconcile job %s: %+v", j.UUID, err)
+		if !recordReconcileError(j, err, r.cfg, time.Now()) {
+			if err := db.UpdateJobRetry(j, oldStatus); err != nil {
+				hlog.Errorf("[Reconciler] failed to schedule retry of job %s: %+v", j.UUID, err)
+			}
+			return
+		}
+		hlog.Infof("[Reconciler] job %s failed after %d attempts", j.UUID, j.RetryCount)
+	}
+	if err := db.UpdateJob(j, oldStatus); err != nil {
+		if errors.Is(err, db.ErrJobStatusConflict) {
+			// another writer moved the job, it is reconciled from its new status in the next pass
+			hlog.Infof("[Reconciler] skipping job %s: %v", j.UUID, err)
+		} else {
+			hlog.Errorf("[Reconciler] failed to update job %s: %+v", j.UUID, err)
 		}
+		return
+	}
+	if err == nil && j.RetryCount > 0 {
+		j.RetryCount = 0
+		j.PermanentErrorCount = 0
+		j.NextAttemptAt = nil
+		if err := db.UpdateJobRetry(j, j.JobStatus); err != nil {
+			hlog.Errorf("[Reconciler] failed to reset retries of job %s: %+v", j.UUID, err)
+		}
+	}
+
+	// the build result is persisted, launch the instance without waiting for the next resync
+	if isLaunchPending(j) {
+		r.queue.Add(j.UUID)
+	}
+
+	// the job released its share of the quotas
+	if !isInProgress(j.JobStatus) {
+		r.admitQueuedJobs()
+	}
+
+	// clean up instance if necessary
+	if j.JobStatus == int(job.JobStatus_VMFinished) || j.JobStatus == int(job.JobStatus_VMFailed) {
+		r.tee.CleanUpInstance(ctx, j.InstanceName)
 	}
 }
 
-func (r *ReconcilerImpl) updateJobStatus(j *db.Job) error {
+func (r *ReconcilerImpl) updateJobStatus(ctx context.Context, j *db.Job) error {
+
+	if j.CancelRequested {
+		return r.handleCancelledJob(ctx, j)
+	}
 
-	// if job was not finished for more than the timeout, mark it as error
-	if time.Since(j.CreatedAt) > 6*time.Hour {
-		hlog.Infof("[Reconciler] job %s is not finished for more than 6 hours. cleaning up...", j.UUID)
-		r.tee.CleanUpInstance(j.InstanceName)
-		j.JobStatus = int(job.JobStatus_VMFailed)
+	// if job was not finished within its timeout, mark it as timed out
+	timeout := getJobTimeout(j)
+	if isTimedOut(j, time.Now()) {
+		hlog.Infof("[Reconciler] job %s is not finished within %v. cleaning up...", j.UUID, timeout)
+		// the job is marked only once torn down, so a failed clean up is retried
+		if j.JobStatus == int(job.JobStatus_ImageBuilding) {
+			if err := r.builder.CancelBuild(ctx, j.UUID); err != nil {
+				return fmt.Errorf("failed to cancel image build: %w", err)
+			}
+		}
+		// the instance is named once the image is built
+		if j.InstanceName != "" {
+			if err := r.tee.CleanUpInstance(ctx, j.InstanceName); err != nil {
+				return fmt.Errorf("failed to clean up instance: %w", err)
+			}
+		}
+		setJobFailure(j, job.JobStatus_Timeout, fmt.Sprintf("job is not finished within the timeout of %v", timeout), "")
 	} else {
 		switch j.JobStatus {
+		case int(job.JobStatus_Queued):
+			// started by admitQueuedJobs
 		case int(job.JobStatus_Created):
-			return r.handleCreatedJob(j)
+			return r.handleCreatedJob(ctx, j)
 		case int(job.JobStatus_ImageBuilding):
-			return r.handleImageBuildingJob(j)
+			return r.handleImageBuildingJob(ctx, j)
 		case int(job.JobStatus_VMWaiting):
-			return r.handleRunningJob(j)
+			return r.handleRunningJob(ctx, j)
 		case int(job.JobStatus_VMRunning):
-			return r.handleRunningJob(j)
+			return r.handleRunningJob(ctx, j)
 		}
 	}
 
 	return nil
 }
 
-func (r *ReconcilerImpl) handleCreatedJob(j *db.Job) error {
+func (r *ReconcilerImpl) handleCancelledJob(ctx context.Context, j *db.Job) error {
+	hlog.Infof("[Reconciler] job %s is cancelled in status %d. cleaning up...", j.UUID, j.JobStatus)
+	switch j.JobStatus {
+	case int(job.JobStatus_ImageBuilding):
+		if err := r.builder.CancelBuild(ctx, j.UUID); err != nil {
+			return fmt.Errorf("failed to cancel image build: %w", err)
+		}
+		// the instance may have been launched without the job being updated
+		if isLaunchPending(j) {
+			if err := r.tee.CleanUpInstance(ctx, j.InstanceName); err != nil {
+				return fmt.Errorf("failed to clean up instance: %w", err)
+			}
+		}
+	case int(job.JobStatus_VMWaiting), int(job.JobStatus_VMRunning):
+		if err := r.tee.CleanUpInstance(ctx, j.InstanceName); err != nil {
+			return fmt.Errorf("failed to clean up instance: %w", err)
+		}
+	}
+	setJobStatus(j, job.JobStatus_VMKilled, "job is cancelled by user")
+	return nil
+}
+
+func (r *ReconcilerImpl) handleCreatedJob(ctx context.Context, j *db.Job) error {
 	// TODO: make the base image configurable
-	registry := registry.GetRegistry()
+	registry, err := registry.GetRegistry(config.Get())
+	if err != nil {
+		return err
+	}
 	baseImage := registry.BaseImage()
 	imageTag := fmt.Sprintf("%s/%s-%s:latest", registry.Url(), j.Creator, j.UUID)
-	err := r.builder.BuildImage(j, baseImage, imageTag)
+	err = r.builder.BuildImage(ctx, j, baseImage, imageTag)
 	if err != nil {
 		hlog.Errorf("failed to build image: %w", err)
 		return err
 	}
-	j.JobStatus = int(job.JobStatus_ImageBuilding)
+	setJobStatus(j, job.JobStatus_ImageBuilding, fmt.Sprintf("building image %s", imageTag))
 	return nil
 }
 
-func (r *ReconcilerImpl) handleImageBuildingJob(j *db.Job) error {
-	done, info, err := r.builder.CheckImageBuilderStatusAndGetInfo(j.UUID)
+// handleImageBuildingJob records the result of the image build, and launches the instance in the next pass once the
+// result is persisted. The launch can then be retried after a restart, although the build job expired.
+func (r *ReconcilerImpl) handleImageBuildingJob(ctx context.Context, j *db.Job) error {
+	if isLaunchPending(j) {
+		return r.launchInstance(ctx, j)
+	}
+	done, info, err := r.builder.CheckImageBuilderStatusAndGetInfo(ctx, j.UUID)
 	if err != nil {
 		return fmt.Errorf("failed to get build status: %w", err)
 	}
 	if !done {
 		return nil
 	}
-	if info != nil {
+	if info != nil && info.Digest != "" {
 		j.DockerImage = info.Image
 		j.DockerImageDigest = info.Digest
-		instanceName := fmt.Sprintf("%s-%s", j.Creator, j.UUID)
-		err := r.tee.LaunchInstance(instanceName, j.DockerImage, j.DockerImageDigest, j.ExtraEnvs)
-		if err != nil {
-			hlog.Errorf("failed to launch instance: %+v", err)
-			j.JobStatus = int(job.JobStatus_VMLaunchFailed)
-			return nil
-		}
-		j.InstanceName = instanceName
-		j.JobStatus = int(job.JobStatus_VMWaiting)
-
+		j.InstanceName = fmt.Sprintf("%s-%s", j.Creator, j.UUID)
+	} else if info != nil && info.FailureReason != "" {
+		setJobFailure(j, job.JobStatus_ImageBuildingFailed, info.FailureReason, info.FailureDetail)
 	} else {
-		j.JobStatus = int(job.JobStatus_ImageBuildingFailed)
+		setJobFailure(j, job.JobStatus_ImageBuildingFailed, "image build failed", "")
 	}
 	return nil
 }
 
-func (r *ReconcilerImpl) handleRunningJob(j *db.Job) error {
-	instanceStatus, err := r.tee.GetInstanceStatus(j.InstanceName)
+// launchInstance launches the instance recorded for the job. Launching an instance that already exists succeeds,
+// in case the job was not updated after a previous launch.
+func (r *ReconcilerImpl) launchInstance(ctx context.Context, j *db.Job) error {
+	if j.InstanceName == "" {
+		j.InstanceName = fmt.Sprintf("%s-%s", j.Creator, j.UUID)
+	}
+	err := r.tee.LaunchInstance(ctx, &tee_backend.LaunchSpec{
+		InstanceName: j.InstanceName,
+		Image:        j.DockerImage,
+		Digest:       j.DockerImageDigest,
+		Creator:      j.Creator,
+		JobUUID:      j.UUID,
+		Resources: tee_backend.Resources{
+			MachineType:              j.MachineType,
+			DiskSizeGB:               j.DiskSizeGB,
+			ConfidentialInstanceType: j.ConfidentialType,
+		},
+		Timeout: getJobTimeout(j),
+		Envs:    j.ExtraEnvs,
+	})
+	if err != nil {
+		hlog.Errorf("failed to launch instance: %+v", err)
+		reason := "failed to launch instance"
+		var launchErr *tee_backend.LaunchError
+		if errors.As(err, &launchErr) {
+			reason = launchErr.Reason
+		}
+		setJobFailure(j, job.JobStatus_VMLaunchFailed, reason, err.Error())
+		return nil
+	}
+	setJobStatus(j, job.JobStatus_VMWaiting, fmt.Sprintf("launched instance %s with image %s", j.InstanceName, j.DockerImage))
+	return nil
+}
+
+// isLaunchPending reports whether the image of the job is built and its instance is still to be launched.
+func isLaunchPending(j *db.Job) bool {
+	return j.JobStatus == int(job.JobStatus_ImageBuilding) && j.DockerImageDigest != ""
+}
+
+func (r *ReconcilerImpl) handleRunningJob(ctx context.Context, j *db.Job) error {
+	instanceStatus, err := r.tee.GetInstanceStatus(ctx, j.InstanceName)
 	if err != nil {
 		return fmt.Errorf("failed to get instance status: %w", err)
 	}
+	reason := fmt.Sprintf("instance %s is %s", j.InstanceName, instanceStatus)
 	if j.JobStatus == int(job.JobStatus_VMWaiting) && instanceStatus == "RUNNING" {
-		j.JobStatus = int(job.JobStatus_VMRunning)
+		setJobStatus(j, job.JobStatus_VMRunning, reason)
 	} else if j.JobStatus == int(job.JobStatus_VMWaiting) && instanceStatus == "TERMINATED" {
-		j.JobStatus = int(job.JobStatus_VMFinished)
+		setJobStatus(j, job.JobStatus_VMFinished, reason)
 	} else if j.JobStatus == int(job.JobStatus_VMRunning) && instanceStatus == "TERMINATED" {
-		j.JobStatus = int(job.JobStatus_VMFinished)
+		setJobStatus(j, job.JobStatus_VMFinished, reason)
 	}
 	return nil
 }
+
+// recordReconcileError schedules the next attempt of a job that failed to reconcile with err, with exponential backoff.
+// Once the job has had cfg.MaxPermanentErrors errors that retrying cannot fix, it moves the job to a failed status
+// instead and returns true.
+func recordReconcileError(j *db.Job, err error, cfg config.ReconcilerConfig, now time.Time) bool {
+	j.RetryCount++
+	if isPermanentError(err) {
+		j.PermanentErrorCount++
+		if j.PermanentErrorCount >= cfg.MaxPermanentErrors {
+			status := job.JobStatus_VMFailed
+			reason := fmt.Sprintf("instance %s no longer exists", j.InstanceName)
+			if j.JobStatus == int(job.JobStatus_Created) || j.JobStatus == int(job.JobStatus_ImageBuilding) {
+				status = job.JobStatus_ImageBuildingFailed
+				reason = "image build no longer exists"
+			}
+			setJobFailure(j, status, reason, err.Error())
+			return true
+		}
+	}
+	next := now.Add(retryDelay(j.RetryCount, cfg))
+	j.NextAttemptAt = &next
+	return false
+}
+
+// isPermanentError reports whether retrying cannot fix err, because what the job depends on was deleted.
+func isPermanentError(err error) bool {
+	return errors.Is(err, tee_backend.ErrInstanceNotFound) || errors.Is(err, imagebuilder.ErrBuildNotFound)
+}
+
+// retryDelay returns the delay before the next attempt after retryCount consecutive failed attempts.
+func retryDelay(retryCount int, cfg config.ReconcilerConfig) time.Duration {
+	delay := cfg.RetryBaseDelay
+	for i := 1; i < retryCount && delay < cfg.RetryMaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, cfg.RetryMaxDelay)
+}
+
+// isInProgress reports whether the reconciler still has to move a job in status, as in db.GetAllInProgressJobs.
+func isInProgress(status int) bool {
+	switch job.JobStatus(status) {
+	case job.JobStatus_Queued, job.JobStatus_Created, job.JobStatus_ImageBuilding, job.JobStatus_VMWaiting, job.JobStatus_VMRunning:
+		return true
+	}
+	return false
+}
+
+// setJobStatus moves the job to status, the reason is recorded in the job event of the transition.
+// The admission of the job is stamped for its timeout, the launch and start of the VM and the end of the job for the
+// usage accounting.
+func setJobStatus(j *db.Job, status job.JobStatus, reason string) {
+	now := time.Now()
+	if status == job.JobStatus_Created && j.AdmittedAt == nil {
+		j.AdmittedAt = &now
+	}
+	if status == job.JobStatus_VMWaiting && j.LaunchedAt == nil {
+		j.LaunchedAt = &now
+	}
+	if status == job.JobStatus_VMRunning && j.StartedAt == nil {
+		j.StartedAt = &now
+	}
+	if !isInProgress(int(status)) && j.FinishedAt == nil {
+		j.FinishedAt = &now
+	}
+	// a VM that ended before it was seen running is accounted from its launch
+	if !isInProgress(int(status)) && j.StartedAt == nil && j.LaunchedAt != nil {
+		j.StartedAt = j.LaunchedAt
+	}
+	j.JobStatus = int(status)
+	j.StatusReason = reason
+}
+
+// setJobFailure moves the job to a failed status, the reason is shown to the job creator.
+func setJobFailure(j *db.Job, status job.JobStatus, reason string, detail string) {
+	setJobStatus(j, status, reason)
+	j.FailureReason = reason
+	j.FailureDetail = detail
+}
+
+func getJobTimeout(j *db.Job) time.Duration {
+	if j.TimeoutSeconds > 0 {
+		return time.Duration(j.TimeoutSeconds) * time.Second
+	}
+	return defaultJobTimeout
+}
+
+// isTimedOut reports whether the job is not finished within its timeout at now. The timeout starts when the job is
+// admitted, as the time spent in the queue does not count. Jobs admitted before the admission was stamped are timed
+// from their submission.
+func isTimedOut(j *db.Job, now time.Time) bool {
+	if j.JobStatus == int(job.JobStatus_Queued) {
+		return false
+	}
+	start := j.CreatedAt
+	if j.AdmittedAt != nil {
+		start = *j.AdmittedAt
+	}
+	return now.Sub(start) > getJobTimeout(j)
+}
@@ -3,18 +3,27 @@ package main
 import (
 	"context"
 	"fmt"
+	"io"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/cloudwego/hertz/pkg/common/test/assert"
 	"github.com/manatee-project/manatee/app/api/biz/dal/db"
 	"github.com/manatee-project/manatee/app/api/biz/model/job"
+	"github.com/manatee-project/manatee/app/api/biz/pkg/config"
+	"github.com/manatee-project/manatee/app/api/biz/pkg/storage"
 	"github.com/manatee-project/manatee/app/reconciler/imagebuilder"
+	"github.com/manatee-project/manatee/app/reconciler/tee_backend"
+	"github.com/pkg/errors"
 	"gorm.io/gorm"
 )
 
+const testDigest = "1253099ce7721d3879373d411fc7938aef80000154c9c0455c2229497ed59336"
+
 type FakeTEEProvider struct {
-	instances map[string]string
+	instances  map[string]string
+	cleanUpErr error
 }
 
 type ImageBuildStatus struct {
@@ -23,9 +32,10 @@ type ImageBuildStatus struct {
 }
 type FakeImageBuilder struct {
 	buildjobs map[string]ImageBuildStatus
+	cancelErr error
 }
 
-func (f *FakeTEEProvider) GetInstanceStatus(instanceName string) (string, error) {
+func (f *FakeTEEProvider) GetInstanceStatus(ctx context.Context, instanceName string) (string, error) {
 	status, ok := f.instances[instanceName]
 	if !ok {
 		return "", fmt.Errorf("instance not found")
@@ -33,17 +43,31 @@ func (f *FakeTEEProvider) GetInstanceStatus(instanceName string) (string, error)
 	return status, nil
 }
 
-func (f *FakeTEEProvider) LaunchInstance(instanceName string, image string, digest string, extraEnvs map[string]string) error {
-	f.instances[instanceName] = "RUNNING"
+func (f *FakeTEEProvider) LaunchInstance(ctx context.Context, spec *tee_backend.LaunchSpec) error {
+	if err := spec.Validate(); err != nil {
+		return err
+	}
+	f.instances[spec.InstanceName] = "RUNNING"
 	return nil
 }
 
-func (f *FakeTEEProvider) CleanUpInstance(instanceName string) error {
+func (f *FakeTEEProvider) CleanUpInstance(ctx context.Context, instanceName string) error {
+	if f.cleanUpErr != nil {
+		return f.cleanUpErr
+	}
 	delete(f.instances, instanceName)
 	return nil
 }
 
-func (f *FakeImageBuilder) BuildImage(j *db.Job, base string, image string) error {
+func (f *FakeTEEProvider) ListInstances(ctx context.Context) ([]tee_backend.Instance, error) {
+	var instances []tee_backend.Instance
+	for name := range f.instances {
+		instances = append(instances, tee_backend.Instance{Name: name})
+	}
+	return instances, nil
+}
+
+func (f *FakeImageBuilder) BuildImage(ctx context.Context, j *db.Job, base string, image string) error {
 	f.buildjobs[j.UUID] = ImageBuildStatus{
 		done: false,
 		info: nil,
@@ -51,7 +75,7 @@ func (f *FakeImageBuilder) BuildImage(j *db.Job, base string, image string) erro
 	return nil
 }
 
-func (f *FakeImageBuilder) CheckImageBuilderStatusAndGetInfo(uuid string) (bool, *imagebuilder.ImageInfo, error) {
+func (f *FakeImageBuilder) CheckImageBuilderStatusAndGetInfo(ctx context.Context, uuid string) (bool, *imagebuilder.ImageInfo, error) {
 	status, ok := f.buildjobs[uuid]
 	if !ok {
 		return false, nil, fmt.Errorf("instance not found")
@@ -59,6 +83,29 @@ func (f *FakeImageBuilder) CheckImageBuilderStatusAndGetInfo(uuid string) (bool,
 	return status.done, status.info, nil
 }
 
+func (f *FakeImageBuilder) CancelBuild(ctx context.Context, uuid string) error {
+	if f.cancelErr != nil {
+		return f.cancelErr
+	}
+	delete(f.buildjobs, uuid)
+	return nil
+}
+
+func (f *FakeImageBuilder) ListBuilds(ctx context.Context) ([]imagebuilder.Build, error) {
+	var builds []imagebuilder.Build
+	for uuid := range f.buildjobs {
+		builds = append(builds, imagebuilder.Build{Name: "kaniko-" + uuid, JobUUID: uuid})
+	}
+	return builds, nil
+}
+
+func (f *FakeImageBuilder) GetBuildLogs(ctx context.Context, uuid string, follow bool, tailLines int64) (io.ReadCloser, error) {
+	if _, ok := f.buildjobs[uuid]; !ok {
+		return nil, imagebuilder.ErrBuildLogsNotFound
+	}
+	return io.NopCloser(strings.NewReader("")), nil
+}
+
 type testCase struct {
 	job               *db.Job
 	expectedJobStatus int
@@ -71,6 +118,8 @@ func TestUpdateJobStatusInstances(t *testing.T) {
 			"instance2": "RUNNING",
 			"instance3": "TERMINATED",
 			"instance4": "RUNNING",
+			"instance5": "RUNNING",
+			"instance6": "RUNNING",
 		},
 	}
 
@@ -121,20 +170,52 @@ func TestUpdateJobStatusInstances(t *testing.T) {
 					UpdatedAt: time.Now().Add(-7 * time.Hour),
 				},
 			},
-			expectedJobStatus: int(job.JobStatus_VMFailed),
+			expectedJobStatus: int(job.JobStatus_Timeout),
+		},
+		{
+			job: &db.Job{
+				UUID:           "job5",
+				JobStatus:      int(job.JobStatus_VMRunning),
+				InstanceName:   "instance5",
+				TimeoutSeconds: 12 * 3600,
+				Model: gorm.Model{
+					CreatedAt: time.Now().Add(-7 * time.Hour),
+					UpdatedAt: time.Now().Add(-7 * time.Hour),
+				},
+			},
+			expectedJobStatus: int(job.JobStatus_VMRunning),
+		},
+		{
+			job: &db.Job{
+				UUID:           "job6",
+				JobStatus:      int(job.JobStatus_VMRunning),
+				InstanceName:   "instance6",
+				TimeoutSeconds: 3600,
+				Model: gorm.Model{
+					CreatedAt: time.Now().Add(-2 * time.Hour),
+					UpdatedAt: time.Now().Add(-2 * time.Hour),
+				},
+			},
+			expectedJobStatus: int(job.JobStatus_Timeout),
 		},
 	}
 
 	reconciler := &ReconcilerImpl{
-		ctx: context.Background(),
 		tee: tee,
 	}
 
 	for _, tc := range tcs {
-		err := reconciler.updateJobStatus(tc.job)
+		err := reconciler.updateJobStatus(context.Background(), tc.job)
 		assert.Nil(t, err)
 		assert.DeepEqual(t, tc.expectedJobStatus, tc.job.JobStatus)
 	}
+	// the usage is accounted from the start of the VM to the end of the job
+	if tcs[0].job.StartedAt == nil || tcs[0].job.FinishedAt != nil {
+		t.Errorf("job1 is running, got started at %v and finished at %v", tcs[0].job.StartedAt, tcs[0].job.FinishedAt)
+	}
+	if tcs[2].job.FinishedAt == nil {
+		t.Errorf("job3 is finished without finished at")
+	}
 }
 
 func TestUpdateJobStatusImageBuilder(t *testing.T) {
@@ -142,14 +223,14 @@ func TestUpdateJobStatusImageBuilder(t *testing.T) {
 		buildjobs: map[string]ImageBuildStatus{
 			"job1": ImageBuildStatus{false, nil},
 			"job2": ImageBuildStatus{true, nil},
-			"job3": ImageBuildStatus{true, &imagebuilder.ImageInfo{Image: "my.image.registry/image", Digest: "deadbeef"}},
+			"job3": ImageBuildStatus{true, &imagebuilder.ImageInfo{Image: "my.image.registry/image@sha256:" + testDigest, Digest: testDigest}},
+			"job4": ImageBuildStatus{true, &imagebuilder.ImageInfo{FailureReason: "Kaniko: COPY failed: no such file"}},
 		},
 	}
 	tee := &FakeTEEProvider{
 		instances: map[string]string{},
 	}
 	reconciler := &ReconcilerImpl{
-		ctx:     context.Background(),
 		builder: builder,
 		tee:     tee,
 	}
@@ -189,12 +270,24 @@ func TestUpdateJobStatusImageBuilder(t *testing.T) {
 					UpdatedAt: time.Now(),
 				},
 			},
-			expectedJobStatus: int(job.JobStatus_VMWaiting),
+			expectedJobStatus: int(job.JobStatus_ImageBuilding),
+		},
+		{
+			job: &db.Job{
+				UUID:      "job4",
+				JobStatus: int(job.JobStatus_ImageBuilding),
+				Creator:   "user1",
+				Model: gorm.Model{
+					CreatedAt: time.Now(),
+					UpdatedAt: time.Now(),
+				},
+			},
+			expectedJobStatus: int(job.JobStatus_ImageBuildingFailed),
 		},
 	}
 
 	for _, tc := range tcs {
-		err := reconciler.updateJobStatus(tc.job)
+		err := reconciler.updateJobStatus(context.Background(), tc.job)
 		if err != nil {
 			t.Errorf("updating %s returns error: %v", tc.job.UUID, err)
 		}
@@ -208,4 +301,203 @@ func TestUpdateJobStatusImageBuilder(t *testing.T) {
 			}
 		}
 	}
+	if tcs[3].job.FailureReason != "Kaniko: COPY failed: no such file" {
+		t.Errorf("failure reason of job4 is not recorded, got %q", tcs[3].job.FailureReason)
+	}
+
+	// the build result is recorded before the instance is launched in the next pass
+	j := tcs[2].job
+	assert.DeepEqual(t, testDigest, j.DockerImageDigest)
+	assert.DeepEqual(t, "user1-job3", j.InstanceName)
+	assert.DeepEqual(t, 0, len(tee.instances))
+	for i := 0; i < 2; i++ {
+		// launching again, as after a restart, succeeds
+		j.JobStatus = int(job.JobStatus_ImageBuilding)
+		assert.Nil(t, reconciler.updateJobStatus(context.Background(), j))
+		assert.DeepEqual(t, int(job.JobStatus_VMWaiting), j.JobStatus)
+		assert.DeepEqual(t, "RUNNING", tee.instances["user1-job3"])
+	}
+	// the build is kept for its logs until its TTL
+	if _, ok := builder.buildjobs["job3"]; !ok {
+		t.Errorf("image build of job3 is deleted after launch")
+	}
+}
+
+func TestUpdateJobStatusCancelled(t *testing.T) {
+	builder := &FakeImageBuilder{
+		buildjobs: map[string]ImageBuildStatus{
+			"job2": ImageBuildStatus{false, nil},
+		},
+	}
+	tee := &FakeTEEProvider{
+		instances: map[string]string{
+			"instance3": "RUNNING",
+		},
+	}
+	reconciler := &ReconcilerImpl{
+		builder: builder,
+		tee:     tee,
+	}
+
+	tcs := []testCase{
+		{
+			job: &db.Job{
+				UUID:            "job1",
+				JobStatus:       int(job.JobStatus_Created),
+				CancelRequested: true,
+			},
+			expectedJobStatus: int(job.JobStatus_VMKilled),
+		},
+		{
+			job: &db.Job{
+				UUID:            "job2",
+				JobStatus:       int(job.JobStatus_ImageBuilding),
+				CancelRequested: true,
+			},
+			expectedJobStatus: int(job.JobStatus_VMKilled),
+		},
+		{
+			job: &db.Job{
+				UUID:            "job3",
+				JobStatus:       int(job.JobStatus_VMRunning),
+				InstanceName:    "instance3",
+				CancelRequested: true,
+			},
+			expectedJobStatus: int(job.JobStatus_VMKilled),
+		},
+	}
+
+	for _, tc := range tcs {
+		err := reconciler.updateJobStatus(context.Background(), tc.job)
+		assert.Nil(t, err)
+		assert.DeepEqual(t, tc.expectedJobStatus, tc.job.JobStatus)
+		assert.DeepEqual(t, "job is cancelled by user", tc.job.StatusReason)
+	}
+	if _, ok := builder.buildjobs["job2"]; ok {
+		t.Errorf("image build of job2 was not cancelled")
+	}
+	if _, ok := tee.instances["instance3"]; ok {
+		t.Errorf("instance of job3 was not cleaned up")
+	}
+}
+
+func TestUpdateJobStatusTimeoutTeardown(t *testing.T) {
+	builder := &FakeImageBuilder{
+		buildjobs: map[string]ImageBuildStatus{
+			"job1": ImageBuildStatus{false, nil},
+		},
+		cancelErr: fmt.Errorf("api server unavailable"),
+	}
+	tee := &FakeTEEProvider{
+		instances: map[string]string{
+			"instance2": "RUNNING",
+		},
+		cleanUpErr: fmt.Errorf("compute api unavailable"),
+	}
+	reconciler := &ReconcilerImpl{
+		builder: builder,
+		tee:     tee,
+	}
+	expired := gorm.Model{CreatedAt: time.Now().Add(-7 * time.Hour)}
+	building := &db.Job{UUID: "job1", JobStatus: int(job.JobStatus_ImageBuilding), Model: expired}
+	running := &db.Job{UUID: "job2", JobStatus: int(job.JobStatus_VMRunning), InstanceName: "instance2", Model: expired}
+
+	// the job is not marked as timed out until it is torn down
+	for _, j := range []*db.Job{building, running} {
+		assert.NotNil(t, reconciler.updateJobStatus(context.Background(), j))
+		assert.True(t, j.JobStatus != int(job.JobStatus_Timeout))
+	}
+
+	builder.cancelErr = nil
+	tee.cleanUpErr = nil
+	for _, j := range []*db.Job{building, running} {
+		assert.Nil(t, reconciler.updateJobStatus(context.Background(), j))
+		assert.DeepEqual(t, int(job.JobStatus_Timeout), j.JobStatus)
+	}
+	if len(builder.buildjobs) != 0 || len(tee.instances) != 0 {
+		t.Errorf("expected the build and instance to be cleaned up, got %v and %v", builder.buildjobs, tee.instances)
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	cfg := config.ReconcilerConfig{RetryBaseDelay: 10 * time.Second, RetryMaxDelay: time.Minute}
+	for retryCount, expected := range map[int]time.Duration{
+		1:  10 * time.Second,
+		2:  20 * time.Second,
+		3:  40 * time.Second,
+		4:  time.Minute,
+		50: time.Minute,
+	} {
+		assert.DeepEqual(t, expected, retryDelay(retryCount, cfg))
+	}
+}
+
+func TestRecordReconcileError(t *testing.T) {
+	cfg := config.ReconcilerConfig{RetryBaseDelay: 10 * time.Second, RetryMaxDelay: time.Minute, MaxPermanentErrors: 2}
+	now := time.Now()
+	j := &db.Job{UUID: "job1", InstanceName: "instance1", JobStatus: int(job.JobStatus_VMRunning)}
+
+	// transient errors are retried with backoff until the job times out
+	for i := 1; i <= 3; i++ {
+		assert.False(t, recordReconcileError(j, fmt.Errorf("connection reset"), cfg, now))
+		assert.DeepEqual(t, now.Add(retryDelay(i, cfg)), *j.NextAttemptAt)
+	}
+	assert.DeepEqual(t, 0, j.PermanentErrorCount)
+
+	notFound := fmt.Errorf("failed to get instance status: %w", tee_backend.ErrInstanceNotFound)
+	assert.False(t, recordReconcileError(j, notFound, cfg, now))
+	assert.DeepEqual(t, int(job.JobStatus_VMRunning), j.JobStatus)
+	assert.True(t, recordReconcileError(j, notFound, cfg, now))
+	assert.DeepEqual(t, int(job.JobStatus_VMFailed), j.JobStatus)
+	assert.DeepEqual(t, "instance instance1 no longer exists", j.FailureReason)
+
+	j = &db.Job{UUID: "job2", JobStatus: int(job.JobStatus_ImageBuilding), PermanentErrorCount: 1}
+	assert.True(t, recordReconcileError(j, errors.Wrap(imagebuilder.ErrBuildNotFound, "kaniko-job2"), cfg, now))
+	assert.DeepEqual(t, int(job.JobStatus_ImageBuildingFailed), j.JobStatus)
+}
+
+func TestIsTimedOut(t *testing.T) {
+	now := time.Now()
+	submitted := gorm.Model{CreatedAt: now.Add(-7 * time.Hour)}
+	admitted := now.Add(-time.Hour)
+
+	// the time in the queue does not count against the timeout
+	assert.False(t, isTimedOut(&db.Job{Model: submitted, JobStatus: int(job.JobStatus_Queued)}, now))
+	assert.False(t, isTimedOut(&db.Job{Model: submitted, JobStatus: int(job.JobStatus_VMRunning), AdmittedAt: &admitted}, now))
+	assert.True(t, isTimedOut(&db.Job{Model: submitted, JobStatus: int(job.JobStatus_VMRunning), AdmittedAt: &admitted, TimeoutSeconds: 1800}, now))
+	// jobs admitted before the admission was stamped are timed from their submission
+	assert.True(t, isTimedOut(&db.Job{Model: submitted, JobStatus: int(job.JobStatus_VMRunning)}, now))
+}
+
+func TestIssueSignedUrls(t *testing.T) {
+	j := &db.Job{UUID: "job1", Creator: "alice", JupyterFileName: "notebook.ipynb"}
+	assert.NotNil(t, (&ReconcilerImpl{}).issueSignedUrls(j))
+
+	r := &ReconcilerImpl{storage: storage.NewMockStorage(context.Background())}
+	assert.Nil(t, r.issueSignedUrls(j))
+	setJobStatus(j, job.JobStatus_Created, "job is admitted within the quotas")
+	if j.AdmittedAt == nil {
+		t.Errorf("job1 is admitted without admitted at")
+	}
+}
+
+func TestSetJobStatusUsage(t *testing.T) {
+	j := &db.Job{UUID: "job1", JobStatus: int(job.JobStatus_ImageBuilding)}
+	setJobStatus(j, job.JobStatus_VMWaiting, "launched instance")
+	if j.LaunchedAt == nil || j.StartedAt != nil {
+		t.Errorf("job1 is launched, got launched at %v and started at %v", j.LaunchedAt, j.StartedAt)
+	}
+	// a VM that ended before it was seen running is accounted from its launch
+	setJobStatus(j, job.JobStatus_VMFinished, "instance is TERMINATED")
+	assert.DeepEqual(t, j.LaunchedAt, j.StartedAt)
+	if j.FinishedAt == nil {
+		t.Errorf("job1 is finished without finished at")
+	}
+
+	// a job without a VM has no usage
+	j = &db.Job{UUID: "job2", JobStatus: int(job.JobStatus_ImageBuilding)}
+	setJobStatus(j, job.JobStatus_ImageBuildingFailed, "image build failed")
+	if j.StartedAt != nil {
+		t.Errorf("job2 is started without a VM at %v", j.StartedAt)
+	}
 }
@@ -2,7 +2,8 @@ package registry
 
 import (
 	"fmt"
-	"os"
+
+	"github.com/manatee-project/manatee/app/api/biz/pkg/config"
 )
 
 type Registry interface {
@@ -11,19 +12,12 @@ type Registry interface {
 }
 
 type GoogleDockerRegistry struct {
+	projectId string
+	env       string
 }
 
 func (g *GoogleDockerRegistry) Url() string {
-	projectId := os.Getenv("PROJECT_ID")
-	if projectId == "" {
-		panic("PROJECT_ID environment variable is not present")
-	}
-	env := os.Getenv("ENV")
-	if env == "" {
-		panic("ENV environment variable is not present")
-	}
-
-	return fmt.Sprintf("us-docker.pkg.dev/%s/dcr-%s-user-images", projectId, env)
+	return fmt.Sprintf("us-docker.pkg.dev/%s/dcr-%s-user-images", g.projectId, g.env)
 }
 
 func (g *GoogleDockerRegistry) BaseImage() string {
@@ -41,16 +35,15 @@ func (m *MinikubeDockerRegistry) BaseImage() string {
 	return fmt.Sprintf("%s/executor:latest", m.Url())
 }
 
-func GetRegistry() Registry {
-	registryType := os.Getenv("REGISTRY_TYPE")
-	if registryType == "" {
-		registryType = "GCP"
-	}
-	var registry Registry
-	if registryType == "GCP" {
-		registry = &GoogleDockerRegistry{}
-	} else if registryType == "MINIKUBE" {
-		registry = &MinikubeDockerRegistry{}
+func GetRegistry(cfg *config.Config) (Registry, error) {
+	switch cfg.RegistryType {
+	case "GCP":
+		if cfg.ProjectID == "" || cfg.Env == "" {
+			return nil, fmt.Errorf("projectId and env are required by the GCP registry")
+		}
+		return &GoogleDockerRegistry{projectId: cfg.ProjectID, env: cfg.Env}, nil
+	case "MINIKUBE":
+		return &MinikubeDockerRegistry{}, nil
 	}
-	return registry
+	return nil, fmt.Errorf("unknown registry type %s", cfg.RegistryType)
 }
@@ -0,0 +1,28 @@
+package main
+
+import (
+	"time"
+
+	"github.com/manatee-project/manatee/app/api/biz/dal/db"
+)
+
+// recentVMUsage returns the VM time of every creator over the window before now.
+func recentVMUsage(now time.Time, window time.Duration) (map[string]time.Duration, error) {
+	since := now.Add(-window)
+	jobs, err := db.QueryJobsOnVMBetween("", since, now)
+	if err != nil {
+		return nil, err
+	}
+	return vmUsage(jobs, since, now), nil
+}
+
+// vmUsage sums the time between since and now that the VMs of the jobs of every creator ran, as in the usage billed.
+func vmUsage(jobs []*db.Job, since time.Time, now time.Time) map[string]time.Duration {
+	usage := make(map[string]time.Duration)
+	for _, j := range jobs {
+		if vmTime := j.VMTime(since, now, now); vmTime > 0 {
+			usage[j.Creator] += vmTime
+		}
+	}
+	return usage
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/common/test/assert"
+	"github.com/manatee-project/manatee/app/api/biz/dal/db"
+	"github.com/manatee-project/manatee/app/api/biz/model/job"
+	"github.com/manatee-project/manatee/app/api/biz/pkg/config"
+)
+
+func TestVMUsage(t *testing.T) {
+	now := time.Now()
+	since := now.Add(-24 * time.Hour)
+	at := func(ago time.Duration) *time.Time {
+		t := now.Add(-ago)
+		return &t
+	}
+	jobs := []*db.Job{
+		// started before the window
+		{UUID: "job1", Creator: "alice", StartedAt: at(29 * time.Hour), FinishedAt: at(20 * time.Hour)},
+		// still running
+		{UUID: "job2", Creator: "alice", StartedAt: at(2 * time.Hour)},
+		{UUID: "job3", Creator: "bob", StartedAt: at(5 * time.Hour), FinishedAt: at(3 * time.Hour)},
+		// never started
+		{UUID: "job4", Creator: "carol", FinishedAt: at(time.Hour)},
+	}
+	usage := vmUsage(jobs, since, now)
+	assert.DeepEqual(t, map[string]time.Duration{"alice": 6 * time.Hour, "bob": 2 * time.Hour}, usage)
+}
+
+func TestAdmitJobsOrder(t *testing.T) {
+	queued := int(job.JobStatus_Queued)
+	jobs := []*db.Job{
+		{UUID: "team1", Creator: "team", JobStatus: queued},
+		{UUID: "team2", Creator: "team", JobStatus: queued},
+		{UUID: "team3", Creator: "team", JobStatus: queued},
+		{UUID: "alice1", Creator: "alice", JobStatus: queued},
+		{UUID: "bob1", Creator: "bob", JobStatus: queued},
+		{UUID: "urgent", Creator: "team", JobStatus: queued, Priority: 5},
+	}
+	admitted := func(quota config.QuotaConfig, usage map[string]time.Duration) []string {
+		var uuids []string
+		for _, j := range admitJobs(jobs, quota, usage) {
+			uuids = append(uuids, j.UUID)
+		}
+		return uuids
+	}
+
+	// without usage, the users take turns in submission order
+	assert.DeepEqual(t, []string{"urgent", "alice1", "bob1", "team1", "team2", "team3"}, admitted(config.QuotaConfig{}, nil))
+	// bob used less VM time than alice
+	usage := map[string]time.Duration{"team": 50 * time.Hour, "alice": 2 * time.Hour, "bob": time.Hour}
+	assert.DeepEqual(t, []string{"urgent", "bob1", "alice1"}, admitted(config.QuotaConfig{MaxJobs: 3}, usage))
+}
@@ -2,20 +2,61 @@ package tee_backend
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"os"
-	"strconv"
+	"net/http"
+	"slices"
+	"time"
 
 	compute "cloud.google.com/go/compute/apiv1"
 	"cloud.google.com/go/compute/apiv1/computepb"
-	"github.com/pkg/errors"
+	"github.com/googleapis/gax-go/v2"
+	"github.com/manatee-project/manatee/app/api/biz/pkg/config"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/iterator"
 	"google.golang.org/protobuf/proto"
 )
 
 type TEEProvider interface {
-	LaunchInstance(instanceName string, image string, digest string, extraEnvs map[string]string) error
-	GetInstanceStatus(instanceName string) (string, error)
-	CleanUpInstance(instanceName string) error
+	LaunchInstance(ctx context.Context, spec *LaunchSpec) error
+	GetInstanceStatus(ctx context.Context, instanceName string) (string, error)
+	CleanUpInstance(ctx context.Context, instanceName string) error
+	// ListInstances returns the TEE instances of this deployment, to find the ones no job refers to.
+	ListInstances(ctx context.Context) ([]Instance, error)
+}
+
+// Instance is a TEE instance returned by ListInstances.
+type Instance struct {
+	Name string
+	// JobUUID is the UUID of the job the instance was launched for, empty if unknown.
+	JobUUID   string
+	CreatedAt time.Time
+}
+
+// ErrInstanceNotFound is returned by GetInstanceStatus when the instance does not exist, for example
+// because it was deleted outside of the reconciler.
+var ErrInstanceNotFound = errors.New("instance not found")
+
+// LaunchError is returned by LaunchInstance with a reason that can be shown to the job creator.
+type LaunchError struct {
+	Reason string
+	Err    error
+}
+
+func (e *LaunchError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Reason, e.Err)
+}
+
+func (e *LaunchError) Unwrap() error {
+	return e.Err
+}
+
+// instancesClient is the part of the Compute Engine instances client used by the backend, faked in tests.
+type instancesClient interface {
+	Get(ctx context.Context, req *computepb.GetInstanceRequest, opts ...gax.CallOption) (*computepb.Instance, error)
+	Insert(ctx context.Context, req *computepb.InsertInstanceRequest, opts ...gax.CallOption) (*compute.Operation, error)
+	Delete(ctx context.Context, req *computepb.DeleteInstanceRequest, opts ...gax.CallOption) (*compute.Operation, error)
+	List(ctx context.Context, req *computepb.ListInstancesRequest, opts ...gax.CallOption) *compute.InstanceIterator
 }
 
 type TEEProviderGCPConfidentialSpace struct {
@@ -25,30 +66,13 @@ type TEEProviderGCPConfidentialSpace struct {
 	env       string
 	saEmail   string
 	debug     bool
-	ctx       context.Context
-	client    *compute.InstancesClient
+	resources config.ResourcesConfig
+	client    instancesClient
 }
 
-func NewTEEProviderGCPConfidentialSpace(ctx context.Context) (*TEEProviderGCPConfidentialSpace, error) {
-	env := os.Getenv("ENV")
-	if env == "" {
-		return nil, fmt.Errorf("ENV environment variable is not present")
-	}
-	projectId := os.Getenv("PROJECT_ID")
-	if projectId == "" {
-		return nil, fmt.Errorf("PROJECT_ID environment variable is not present")
-	}
-	region := os.Getenv("REGION")
-	if projectId == "" {
-		return nil, fmt.Errorf("REGION environment variable is not present")
-	}
-	zone := os.Getenv("ZONE")
-	if projectId == "" {
-		return nil, fmt.Errorf("ZONE environment variable is not present")
-	}
-	debug, err := strconv.ParseBool(os.Getenv("DEBUG"))
-	if err != nil {
-		debug = false
+func NewTEEProviderGCPConfidentialSpace(ctx context.Context, cfg *config.Config) (*TEEProviderGCPConfidentialSpace, error) {
+	if cfg.Env == "" || cfg.ProjectID == "" || cfg.Region == "" || cfg.Zone == "" {
+		return nil, fmt.Errorf("env, projectId, region and zone are required by the GCP TEE backend")
 	}
 	client, err := compute.NewInstancesRESTClient(ctx)
 	if err != nil {
@@ -56,52 +80,105 @@ func NewTEEProviderGCPConfidentialSpace(ctx context.Context) (*TEEProviderGCPCon
 	}
 
 	return &TEEProviderGCPConfidentialSpace{
-		projectId: projectId,
-		region:    region,
-		zone:      zone,
-		env:       env,
-		saEmail:   fmt.Sprintf("dcr-%s-cvm-sa@%s.iam.gserviceaccount.com", env, projectId),
-		debug:     debug,
-		ctx:       ctx,
+		projectId: cfg.ProjectID,
+		region:    cfg.Region,
+		zone:      cfg.Zone,
+		env:       cfg.Env,
+		saEmail:   fmt.Sprintf("dcr-%s-cvm-sa@%s.iam.gserviceaccount.com", cfg.Env, cfg.ProjectID),
+		debug:     cfg.Debug,
+		resources: cfg.Resources,
 		client:    client,
 	}, nil
 }
 
-func (c *TEEProviderGCPConfidentialSpace) GetInstanceStatus(instanceName string) (string, error) {
+func (c *TEEProviderGCPConfidentialSpace) GetInstanceStatus(ctx context.Context, instanceName string) (string, error) {
 
 	req := &computepb.GetInstanceRequest{
 		Project:  c.projectId,
 		Zone:     c.zone,
 		Instance: instanceName,
 	}
-	resp, err := c.client.Get(c.ctx, req)
+	resp, err := c.client.Get(ctx, req)
 	if err != nil {
+		if isNotFound(err) {
+			return "", fmt.Errorf("%w: %s", ErrInstanceNotFound, instanceName)
+		}
 		return "", fmt.Errorf("failed to get instance: %w", err)
 	}
 
 	return *resp.Status, nil
 }
 
-func (c *TEEProviderGCPConfidentialSpace) CleanUpInstance(instanceName string) error {
+func (c *TEEProviderGCPConfidentialSpace) CleanUpInstance(ctx context.Context, instanceName string) error {
 
 	req := &computepb.DeleteInstanceRequest{
 		Project:  c.projectId,
 		Zone:     c.zone,
 		Instance: instanceName,
 	}
-	op, err := c.client.Delete(c.ctx, req)
-	if err != nil {
+	op, err := c.client.Delete(ctx, req)
+	if isNotFound(err) {
 		// instance already does not exist
 		return nil
 	}
-	if err = op.Wait(c.ctx); err != nil {
+	if err != nil {
+		// the instance may still be running, so the clean up is retried
+		return fmt.Errorf("failed to delete instance: %w", err)
+	}
+	if err = op.Wait(ctx); err != nil {
 		return fmt.Errorf("failed to wait for delete operation to complete: %w", err)
 	}
 	return nil
 }
 
-func (c *TEEProviderGCPConfidentialSpace) LaunchInstance(instanceName string, image string, digest string, extraEnvs map[string]string) error {
-	err := c.createConfidentialSpace(instanceName, image, extraEnvs)
+// isNotFound reports whether err is a 404 from the Compute Engine API.
+func isNotFound(err error) bool {
+	var apiErr *googleapi.Error
+	return errors.As(err, &apiErr) && apiErr.Code == http.StatusNotFound
+}
+
+func (c *TEEProviderGCPConfidentialSpace) ListInstances(ctx context.Context) ([]Instance, error) {
+	network := c.networkURL()
+	it := c.client.List(ctx, &computepb.ListInstancesRequest{
+		Project: c.projectId,
+		Zone:    c.zone,
+	})
+	var instances []Instance
+	for {
+		instance, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to list instances: %w", err)
+		}
+		// other deployments may launch instances in the same zone
+		if !slices.Contains(instance.GetTags().GetItems(), "tee-instance") ||
+			len(instance.GetNetworkInterfaces()) == 0 || instance.GetNetworkInterfaces()[0].GetNetwork() != network {
+			continue
+		}
+		createdAt, err := time.Parse(time.RFC3339, instance.GetCreationTimestamp())
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse creation time of instance %s: %w", instance.GetName(), err)
+		}
+		jobUUID := instance.GetLabels()["manatee-job-uuid"]
+		if jobUUID == "" {
+			jobUUID = jobUUIDFromInstanceName(instance.GetName())
+		}
+		instances = append(instances, Instance{
+			Name:      instance.GetName(),
+			JobUUID:   jobUUID,
+			CreatedAt: createdAt,
+		})
+	}
+	return instances, nil
+}
+
+func (c *TEEProviderGCPConfidentialSpace) LaunchInstance(ctx context.Context, spec *LaunchSpec) error {
+	if err := spec.Validate(); err != nil {
+		return &LaunchError{Reason: "GCP: invalid launch spec", Err: err}
+	}
+	err := c.createConfidentialSpace(ctx, spec)
 	if err != nil {
 		return err
 	}
@@ -109,22 +186,31 @@ func (c *TEEProviderGCPConfidentialSpace) LaunchInstance(instanceName string, im
 	return nil
 }
 
-func (c *TEEProviderGCPConfidentialSpace) createConfidentialSpace(instanceName string, dockerImage string, extraEnvs map[string]string) error {
+func (c *TEEProviderGCPConfidentialSpace) createConfidentialSpace(ctx context.Context, spec *LaunchSpec) error {
 
-	req := c.getConfidentialSpaceInsertInstanceRequest(instanceName, dockerImage, extraEnvs)
+	req := c.getConfidentialSpaceInsertInstanceRequest(spec)
 
-	op, err := c.client.Insert(c.ctx, req)
+	op, err := c.client.Insert(ctx, req)
+	var apiErr *googleapi.Error
+	if errors.As(err, &apiErr) && apiErr.Code == http.StatusConflict {
+		// launched before the job was updated, the instance has the same name and spec
+		return nil
+	}
 	if err != nil {
-		return errors.Wrap(err, "failed to insert instance")
+		return &LaunchError{Reason: "GCP: failed to create confidential space instance", Err: err}
 	}
-	if err = op.Wait(c.ctx); err != nil {
-		return errors.Wrap(err, "failed to wait for insert operation to complete")
+	if err = op.Wait(ctx); err != nil {
+		return &LaunchError{Reason: fmt.Sprintf("GCP: confidential space instance was not created: %v", err), Err: err}
 	}
 	return nil
 }
 
-func (c *TEEProviderGCPConfidentialSpace) getConfidentialSpaceInsertInstanceRequest(instanceName string, dockerImage string, extraEnvs map[string]string) *computepb.InsertInstanceRequest {
-	network := fmt.Sprintf("https://compute.googleapis.com/compute/v1/projects/%s/global/networks/dcr-%s-network", c.projectId, c.env)
+func (c *TEEProviderGCPConfidentialSpace) networkURL() string {
+	return fmt.Sprintf("https://compute.googleapis.com/compute/v1/projects/%s/global/networks/dcr-%s-network", c.projectId, c.env)
+}
+
+func (c *TEEProviderGCPConfidentialSpace) getConfidentialSpaceInsertInstanceRequest(spec *LaunchSpec) *computepb.InsertInstanceRequest {
+	network := c.networkURL()
 	subNetwork := fmt.Sprintf("https://compute.googleapis.com/compute/v1/projects/%s/regions/%s/subnetworks/dcr-%s-subnetwork", c.projectId, c.region, c.env)
 
 	imageSource := "https://compute.googleapis.com/compute/v1/projects/confidential-space-images/global/images/family/confidential-space"
@@ -134,10 +220,22 @@ func (c *TEEProviderGCPConfidentialSpace) getConfidentialSpaceInsertInstanceRequ
 		logRedirectFlag = "true"
 	}
 
-	// TODO: make machine type configurable
-	machineType := fmt.Sprintf("zones/%s/machineTypes/c3-standard-8", c.zone)
-	// TODO: make disksize configurable
-	diskSize := int64(50)
+	// jobs submitted before resources were recorded use the defaults
+	resources := spec.Resources
+	if resources.MachineType == "" {
+		resources.MachineType = c.resources.MachineType
+	}
+	if resources.DiskSizeGB == 0 {
+		resources.DiskSizeGB = c.resources.DiskSizeGB
+	}
+	if resources.ConfidentialInstanceType == "" {
+		resources.ConfidentialInstanceType = c.resources.ConfidentialInstanceType
+	}
+	machineType := fmt.Sprintf("zones/%s/machineTypes/%s", c.zone, resources.MachineType)
+	diskSize := resources.DiskSizeGB
+	instanceName := spec.InstanceName
+	// the attested workload must be the image that was built, so it is launched by digest
+	dockerImage := spec.ImageReference()
 
 	metadataItems := []*computepb.Items{&computepb.Items{
 		Key:   proto.String("tee-container-log-redirect"),
@@ -148,16 +246,25 @@ func (c *TEEProviderGCPConfidentialSpace) getConfidentialSpaceInsertInstanceRequ
 	},
 	}
 
-	for key, value := range extraEnvs {
+	for key, value := range spec.Envs {
 		metadataItems = append(metadataItems, &computepb.Items{
 			Key:   proto.String(fmt.Sprintf("tee-env-%s", key)),
 			Value: proto.String(value),
 		})
 	}
 
+	scheduling := &computepb.Scheduling{
+		OnHostMaintenance: proto.String("TERMINATE"),
+	}
+	if spec.Timeout > 0 {
+		// delete the instance if the reconciler fails to clean it up after the timeout
+		scheduling.MaxRunDuration = &computepb.Duration{Seconds: proto.Int64(int64(spec.Timeout.Seconds()))}
+		scheduling.InstanceTerminationAction = proto.String("DELETE")
+	}
+
 	instanceResource := computepb.Instance{
 		ConfidentialInstanceConfig: &computepb.ConfidentialInstanceConfig{
-			ConfidentialInstanceType: proto.String("TDX"),
+			ConfidentialInstanceType: proto.String(resources.ConfidentialInstanceType),
 		},
 		ShieldedInstanceConfig: &computepb.ShieldedInstanceConfig{
 			EnableSecureBoot: proto.Bool(true),
@@ -175,6 +282,7 @@ func (c *TEEProviderGCPConfidentialSpace) getConfidentialSpaceInsertInstanceRequ
 			},
 		},
 		Name:        &instanceName,
+		Labels:      spec.Labels("manatee-"),
 		MachineType: &machineType,
 		Disks: []*computepb.AttachedDisk{
 			&computepb.AttachedDisk{
@@ -186,9 +294,7 @@ func (c *TEEProviderGCPConfidentialSpace) getConfidentialSpaceInsertInstanceRequ
 				},
 			},
 		},
-		Scheduling: &computepb.Scheduling{
-			OnHostMaintenance: proto.String("TERMINATE"),
-		},
+		Scheduling: scheduling,
 		NetworkInterfaces: []*computepb.NetworkInterface{
 			&computepb.NetworkInterface{
 				AccessConfigs: []*computepb.AccessConfig{
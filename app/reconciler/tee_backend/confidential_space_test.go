@@ -0,0 +1,96 @@
+package tee_backend
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	compute "cloud.google.com/go/compute/apiv1"
+	"cloud.google.com/go/compute/apiv1/computepb"
+	"github.com/googleapis/gax-go/v2"
+	"github.com/manatee-project/manatee/app/api/biz/pkg/config"
+	"google.golang.org/api/googleapi"
+)
+
+func TestInsertInstanceRequestResources(t *testing.T) {
+	c := &TEEProviderGCPConfidentialSpace{
+		projectId: "project",
+		region:    "us-west1",
+		zone:      "us-west1-b",
+		env:       "dev",
+		resources: config.Default().Resources,
+	}
+
+	spec := &LaunchSpec{
+		InstanceName: "instance",
+		Image:        "registry.example.com/image:latest",
+		Digest:       testDigest,
+		Creator:      "alice",
+		JobUUID:      "job1",
+		Timeout:      time.Hour,
+	}
+	req := c.getConfidentialSpaceInsertInstanceRequest(spec)
+	instance := req.InstanceResource
+	if instance.GetMachineType() != "zones/us-west1-b/machineTypes/c3-standard-8" {
+		t.Errorf("unexpected default machine type %s", instance.GetMachineType())
+	}
+	if instance.Disks[0].GetDiskSizeGb() != 50 || instance.ConfidentialInstanceConfig.GetConfidentialInstanceType() != "TDX" {
+		t.Errorf("unexpected default disk size %d or confidential instance type %s", instance.Disks[0].GetDiskSizeGb(), instance.ConfidentialInstanceConfig.GetConfidentialInstanceType())
+	}
+
+	var imageReference string
+	for _, item := range instance.Metadata.Items {
+		if item.GetKey() == "tee-image-reference" {
+			imageReference = item.GetValue()
+		}
+	}
+	if imageReference != "registry.example.com/image@sha256:"+testDigest {
+		t.Errorf("image is not pinned to its digest: %s", imageReference)
+	}
+	if instance.Labels["manatee-creator"] != "alice" || instance.Labels["manatee-job-uuid"] != "job1" {
+		t.Errorf("unexpected labels %v", instance.Labels)
+	}
+	if instance.Scheduling.GetMaxRunDuration().GetSeconds() != 3600 || instance.Scheduling.GetInstanceTerminationAction() != "DELETE" {
+		t.Errorf("unexpected scheduling %v", instance.Scheduling)
+	}
+
+	spec.Resources = Resources{
+		MachineType:              "n2d-highmem-32",
+		DiskSizeGB:               500,
+		ConfidentialInstanceType: "SEV_SNP",
+	}
+	req = c.getConfidentialSpaceInsertInstanceRequest(spec)
+	instance = req.InstanceResource
+	if instance.GetMachineType() != "zones/us-west1-b/machineTypes/n2d-highmem-32" {
+		t.Errorf("unexpected machine type %s", instance.GetMachineType())
+	}
+	if instance.Disks[0].GetDiskSizeGb() != 500 || instance.ConfidentialInstanceConfig.GetConfidentialInstanceType() != "SEV_SNP" {
+		t.Errorf("unexpected disk size %d or confidential instance type %s", instance.Disks[0].GetDiskSizeGb(), instance.ConfidentialInstanceConfig.GetConfidentialInstanceType())
+	}
+}
+
+// fakeInstancesClient fails every call with err.
+type fakeInstancesClient struct {
+	instancesClient
+	err error
+}
+
+func (f *fakeInstancesClient) Delete(context.Context, *computepb.DeleteInstanceRequest, ...gax.CallOption) (*compute.Operation, error) {
+	return nil, f.err
+}
+
+func TestCleanUpInstanceErrors(t *testing.T) {
+	notFound := &googleapi.Error{Code: http.StatusNotFound}
+	c := &TEEProviderGCPConfidentialSpace{client: &fakeInstancesClient{err: notFound}}
+	if err := c.CleanUpInstance(context.Background(), "instance"); err != nil {
+		t.Errorf("expected a missing instance to be cleaned up, got %v", err)
+	}
+
+	forbidden := &googleapi.Error{Code: http.StatusForbidden}
+	c.client = &fakeInstancesClient{err: forbidden}
+	if err := c.CleanUpInstance(context.Background(), "instance"); !errors.Is(err, forbidden) {
+		t.Errorf("expected the delete error to be returned, got %v", err)
+	}
+}
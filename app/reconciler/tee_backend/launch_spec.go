@@ -0,0 +1,84 @@
+package tee_backend
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Resources selects the machine an instance runs on. Empty fields fall back to the configured defaults.
+type Resources struct {
+	MachineType              string
+	DiskSizeGB               int64
+	ConfidentialInstanceType string
+}
+
+// LaunchSpec describes the workload of a TEE instance.
+type LaunchSpec struct {
+	InstanceName string
+	// Image is the image built for the job. Any tag or digest is replaced by Digest when launching.
+	Image string
+	// Digest is the sha256 digest reported by the image builder, without the sha256: prefix.
+	Digest    string
+	Creator   string
+	JobUUID   string
+	Resources Resources
+	// Timeout bounds the run time of the instance from its launch, as a safety net for the reconciler.
+	Timeout time.Duration
+	Envs    map[string]string
+}
+
+var digestRegexp = regexp.MustCompile(`^[a-f0-9]{64}$`)
+
+// Validate checks that the spec pins the image to a digest.
+func (s *LaunchSpec) Validate() error {
+	if s.InstanceName == "" || s.Image == "" {
+		return fmt.Errorf("instance name and image are required")
+	}
+	if !digestRegexp.MatchString(s.Digest) {
+		return fmt.Errorf("image %s has no valid sha256 digest: %q", s.Image, s.Digest)
+	}
+	return nil
+}
+
+// ImageReference returns the image pinned to its digest, as repository@sha256:digest.
+func (s *LaunchSpec) ImageReference() string {
+	repository := s.Image
+	if i := strings.Index(repository, "@"); i != -1 {
+		repository = repository[:i]
+	}
+	// a colon after the last slash starts a tag, before it is a registry port
+	if i := strings.LastIndex(repository, ":"); i > strings.LastIndex(repository, "/") {
+		repository = repository[:i]
+	}
+	return fmt.Sprintf("%s@sha256:%s", repository, s.Digest)
+}
+
+// Labels returns the labels identifying the job of the instance, with keys starting with prefix.
+// Values are restricted to the characters allowed by both GCP and Kubernetes labels.
+func (s *LaunchSpec) Labels(prefix string) map[string]string {
+	return map[string]string{
+		prefix + "creator":  labelValue(s.Creator),
+		prefix + "job-uuid": labelValue(s.JobUUID),
+	}
+}
+
+// jobUUIDFromInstanceName returns the job UUID of an instance named <creator>-<uuid>, for instances launched without labels.
+func jobUUIDFromInstanceName(name string) string {
+	const uuidLength = 36
+	if len(name) <= uuidLength || name[len(name)-uuidLength-1] != '-' {
+		return ""
+	}
+	return name[len(name)-uuidLength:]
+}
+
+var invalidLabelChars = regexp.MustCompile(`[^a-z0-9_-]`)
+
+func labelValue(value string) string {
+	value = invalidLabelChars.ReplaceAllString(strings.ToLower(value), "-")
+	if len(value) > 63 {
+		value = value[:63]
+	}
+	return strings.Trim(value, "-_")
+}
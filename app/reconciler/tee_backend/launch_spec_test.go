@@ -0,0 +1,59 @@
+package tee_backend
+
+import (
+	"testing"
+)
+
+const testDigest = "1253099ce7721d3879373d411fc7938aef80000154c9c0455c2229497ed59336"
+
+func TestImageReference(t *testing.T) {
+	for image, expected := range map[string]string{
+		"registry.example.com/repo/image":                              "registry.example.com/repo/image@sha256:" + testDigest,
+		"registry.example.com/repo/image:latest":                       "registry.example.com/repo/image@sha256:" + testDigest,
+		"registry.example.com:5000/repo/image:latest":                  "registry.example.com:5000/repo/image@sha256:" + testDigest,
+		"registry.example.com:5000/repo/image":                         "registry.example.com:5000/repo/image@sha256:" + testDigest,
+		"registry.example.com/repo/image@sha256:" + testDigest:         "registry.example.com/repo/image@sha256:" + testDigest,
+		"registry.example.com/repo/image:latest@sha256:0123456789abcd": "registry.example.com/repo/image@sha256:" + testDigest,
+	} {
+		spec := &LaunchSpec{Image: image, Digest: testDigest}
+		if ref := spec.ImageReference(); ref != expected {
+			t.Errorf("ImageReference() of %s = %s, expected %s", image, ref, expected)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	spec := &LaunchSpec{InstanceName: "instance", Image: "registry.example.com/image", Digest: testDigest}
+	if err := spec.Validate(); err != nil {
+		t.Errorf("expected valid spec, got %v", err)
+	}
+	for _, digest := range []string{"", "deadbeef", "sha256:" + testDigest} {
+		spec.Digest = digest
+		if err := spec.Validate(); err == nil {
+			t.Errorf("expected error for digest %q", digest)
+		}
+	}
+}
+
+func TestLabels(t *testing.T) {
+	spec := &LaunchSpec{Creator: "Alice.Smith@example.com", JobUUID: "5b1d3c3e-9a1f-11ef-b864-0242ac120002"}
+	labels := spec.Labels("manatee-")
+	if labels["manatee-creator"] != "alice-smith-example-com" {
+		t.Errorf("unexpected creator label %q", labels["manatee-creator"])
+	}
+	if labels["manatee-job-uuid"] != "5b1d3c3e-9a1f-11ef-b864-0242ac120002" {
+		t.Errorf("unexpected job uuid label %q", labels["manatee-job-uuid"])
+	}
+}
+
+func TestJobUUIDFromInstanceName(t *testing.T) {
+	for name, expected := range map[string]string{
+		"alice-5b1d3c3e-9a1f-11ef-b864-0242ac120002": "5b1d3c3e-9a1f-11ef-b864-0242ac120002",
+		"5b1d3c3e-9a1f-11ef-b864-0242ac120002":       "",
+		"alice-instance":                             "",
+	} {
+		if uuid := jobUUIDFromInstanceName(name); uuid != expected {
+			t.Errorf("expected job uuid %q for instance %s, got %q", expected, name, uuid)
+		}
+	}
+}
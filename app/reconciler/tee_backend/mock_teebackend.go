@@ -7,21 +7,30 @@ import (
 	"strings"
 
 	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/manatee-project/manatee/app/api/biz/pkg/config"
 	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
+	batchlisters "k8s.io/client-go/listers/batch/v1"
 	"k8s.io/client-go/rest"
 )
 
+// mockKeyDir is where the mock attestation key is mounted in the workloads.
+const mockKeyDir = "/var/run/manatee/mock-attestation"
+
 type MockTeeBackend struct {
-	ctx       context.Context
 	clientSet *kubernetes.Clientset
 	namespace string
+	jobLister batchlisters.JobLister
+	// keySecret holds the key the workloads sign their attestation tokens with.
+	keySecret string
 }
 
-func NewMockTeeBackend(ctx context.Context) (*MockTeeBackend, error) {
+func NewMockTeeBackend(cfg *config.Config) (*MockTeeBackend, error) {
 	clusterConfig, err := rest.InClusterConfig()
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to init cluster config")
@@ -38,17 +47,29 @@ func NewMockTeeBackend(ctx context.Context) (*MockTeeBackend, error) {
 	}
 	namespace := string(RunningNameSpaceByte)
 
+	// outside mock mode the workloads emit unsigned tokens, as any token signed with a key they hold is forgeable
+	var keySecret string
+	if cfg.Attestation.MockEnabled {
+		keySecret = cfg.Attestation.MockKeySecret
+	}
 	return &MockTeeBackend{
-		ctx:       ctx,
 		clientSet: clientSet,
 		namespace: namespace,
+		keySecret: keySecret,
 	}, nil
 }
 
-func (m *MockTeeBackend) LaunchInstance(instanceName string, image string, digest string, extraEnvs map[string]string) error {
+func (m *MockTeeBackend) LaunchInstance(ctx context.Context, spec *LaunchSpec) error {
+	if err := spec.Validate(); err != nil {
+		return &LaunchError{Reason: "Mock TEE: invalid launch spec", Err: err}
+	}
 	ttlSecondsAfterFinished := int32(3600 * 3)
+	var activeDeadlineSeconds *int64
+	if spec.Timeout > 0 {
+		activeDeadlineSeconds = proto.Int64(int64(spec.Timeout.Seconds()))
+	}
 	var envs []corev1.EnvVar
-	for key, value := range extraEnvs {
+	for key, value := range spec.Envs {
 		envs = append(envs, corev1.EnvVar{
 			Name:  key,
 			Value: value,
@@ -56,51 +77,131 @@ func (m *MockTeeBackend) LaunchInstance(instanceName string, image string, diges
 	}
 	envs = append(envs, corev1.EnvVar{
 		Name:  "TEE_BACKEND",
-		Value: os.Getenv("TEE_BACKEND"),
+		Value: "MOCK",
 	},
+		// the claims the workload attests to, as the Confidential Space launcher would
+		corev1.EnvVar{
+			Name:  "MOCK_IMAGE_DIGEST",
+			Value: "sha256:" + spec.Digest,
+		},
 	)
+	var volumes []corev1.Volume
+	var volumeMounts []corev1.VolumeMount
+	if m.keySecret != "" {
+		envs = append(envs, corev1.EnvVar{
+			Name:  "MOCK_ATTESTATION_KEY_FILE",
+			Value: mockKeyDir + "/key.pem",
+		})
+		volumes = append(volumes, corev1.Volume{
+			Name: "mock-attestation-key",
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: m.keySecret,
+					// without the secret, the workloads emit unsigned tokens
+					Optional: proto.Bool(true),
+				},
+			},
+		})
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      "mock-attestation-key",
+			MountPath: mockKeyDir,
+			ReadOnly:  true,
+		})
+	}
 	mockTeeJob := &batchv1.Job{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      instanceName,
+			Name:      spec.InstanceName,
 			Namespace: m.namespace,
+			Labels:    spec.Labels("manatee.io/"),
 		},
 		Spec: batchv1.JobSpec{
 			TTLSecondsAfterFinished: &ttlSecondsAfterFinished,
+			ActiveDeadlineSeconds:   activeDeadlineSeconds,
 			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: spec.Labels("manatee.io/"),
+				},
 				Spec: corev1.PodSpec{
 					ServiceAccountName: "dcr-k8s-pod-sa",
 					Containers: []corev1.Container{
 						{
-							Name:  "mock-tee",
-							Image: convertImageToLocal(image),
-							Env:   envs,
+							Name:         "mock-tee",
+							Image:        convertImageToLocal(spec.ImageReference()),
+							Env:          envs,
+							VolumeMounts: volumeMounts,
 						},
 					},
+					Volumes:       volumes,
 					RestartPolicy: "Never",
 				},
 			},
 		},
 	}
-	_, err := m.clientSet.BatchV1().Jobs(m.namespace).Create(m.ctx, mockTeeJob, metav1.CreateOptions{})
+	_, err := m.clientSet.BatchV1().Jobs(m.namespace).Create(ctx, mockTeeJob, metav1.CreateOptions{})
+	if apierrors.IsAlreadyExists(err) {
+		hlog.Infof("[MockTeeBackend]mock tee %s is already launched", spec.InstanceName)
+		return nil
+	}
 	if err != nil {
-		return errors.Wrap(err, "failed to create kubernetes job")
+		return &LaunchError{Reason: "Mock TEE: failed to create kubernetes job", Err: err}
 	}
 	return nil
 }
 
-func (m *MockTeeBackend) CleanUpInstance(instanceName string) error {
+func (m *MockTeeBackend) ListInstances(ctx context.Context) ([]Instance, error) {
+	// unlike the image builds, the instances are labelled with their creator
+	teeJobs, err := m.clientSet.BatchV1().Jobs(m.namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: "manatee.io/creator",
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list jobs")
+	}
+	var instances []Instance
+	for _, teeJob := range teeJobs.Items {
+		instances = append(instances, Instance{
+			Name:      teeJob.Name,
+			JobUUID:   teeJob.Labels["manatee.io/job-uuid"],
+			CreatedAt: teeJob.CreationTimestamp.Time,
+		})
+	}
+	return instances, nil
+}
+
+func (m *MockTeeBackend) CleanUpInstance(ctx context.Context, instanceName string) error {
 	deletePolicy := metav1.DeletePropagationForeground
-	if err := m.clientSet.BatchV1().Jobs(m.namespace).Delete(m.ctx, instanceName, metav1.DeleteOptions{
+	if err := m.clientSet.BatchV1().Jobs(m.namespace).Delete(ctx, instanceName, metav1.DeleteOptions{
 		PropagationPolicy: &deletePolicy,
 	}); err != nil {
+		if apierrors.IsNotFound(err) {
+			// instance already does not exist
+			return nil
+		}
 		return errors.Wrap(err, "failed to delete job")
 	}
 	return nil
 }
 
-func (m *MockTeeBackend) GetInstanceStatus(instanceName string) (string, error) {
-	teeJob, err := m.clientSet.BatchV1().Jobs(m.namespace).Get(m.ctx, instanceName, metav1.GetOptions{})
+// SetJobLister makes the backend read the instances from an informer cache instead of the API server.
+func (m *MockTeeBackend) SetJobLister(lister batchlisters.JobLister) {
+	m.jobLister = lister
+}
+
+func (m *MockTeeBackend) getJob(ctx context.Context, name string) (*batchv1.Job, error) {
+	if m.jobLister != nil {
+		// an instance launched since the last event is not in the cache yet
+		if teeJob, err := m.jobLister.Jobs(m.namespace).Get(name); err == nil {
+			return teeJob, nil
+		}
+	}
+	return m.clientSet.BatchV1().Jobs(m.namespace).Get(ctx, name, metav1.GetOptions{})
+}
+
+func (m *MockTeeBackend) GetInstanceStatus(ctx context.Context, instanceName string) (string, error) {
+	teeJob, err := m.getJob(ctx, instanceName)
 	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", errors.Wrap(ErrInstanceNotFound, instanceName)
+		}
 		hlog.Errorf("[MockTeeBackend]failed to get mock tee job: %v", err)
 		return "", errors.Wrap(err, "failed to get job")
 	}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"k8s.io/client-go/util/workqueue"
+)
+
+// jobQueue reconciles jobs, identified by UUID, on a bounded number of workers. A job is reconciled by
+// at most one worker at a time, and a job added while it is reconciled is reconciled again afterwards.
+type jobQueue struct {
+	workers  int
+	deadline time.Duration
+	process  func(ctx context.Context, uuid string)
+	queue    workqueue.TypedInterface[string]
+	wg       sync.WaitGroup
+}
+
+func newJobQueue(workers int, deadline time.Duration, process func(ctx context.Context, uuid string)) *jobQueue {
+	return &jobQueue{
+		workers:  workers,
+		deadline: deadline,
+		process:  process,
+		queue:    workqueue.NewTypedWithConfig(workqueue.TypedQueueConfig[string]{Name: "jobs"}),
+	}
+}
+
+// Start starts the workers. Once ctx is done, they finish the job they are reconciling and stop.
+func (q *jobQueue) Start(ctx context.Context) {
+	for i := 0; i < q.workers; i++ {
+		q.wg.Add(1)
+		go func() {
+			defer q.wg.Done()
+			for q.next(ctx) {
+			}
+		}()
+	}
+	go func() {
+		<-ctx.Done()
+		q.queue.ShutDown()
+	}()
+}
+
+func (q *jobQueue) next(ctx context.Context) bool {
+	uuid, shutdown := q.queue.Get()
+	if shutdown {
+		return false
+	}
+	defer q.queue.Done(uuid)
+	if ctx.Err() != nil {
+		// drop the queued jobs on shutdown
+		return true
+	}
+	// a job in progress is not interrupted on shutdown, only bounded by its deadline
+	jobCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), q.deadline)
+	defer cancel()
+	q.process(jobCtx, uuid)
+	return true
+}
+
+// Add queues the job for reconciliation. It does not wait for a worker.
+func (q *jobQueue) Add(uuid string) {
+	q.queue.Add(uuid)
+}
+
+// Wait waits for the workers to stop.
+func (q *jobQueue) Wait() {
+	q.wg.Wait()
+}
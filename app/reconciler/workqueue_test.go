@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/common/test/assert"
+)
+
+func TestJobQueue(t *testing.T) {
+	var running, maxRunning atomic.Int32
+	var mu sync.Mutex
+	processed := map[string]int{}
+	started := make(chan string)
+	release := make(chan struct{})
+	q := newJobQueue(2, time.Minute, func(ctx context.Context, uuid string) {
+		n := running.Add(1)
+		for {
+			m := maxRunning.Load()
+			if n <= m || maxRunning.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		if _, ok := ctx.Deadline(); !ok {
+			t.Errorf("job %s has no deadline", uuid)
+		}
+		started <- uuid
+		<-release
+		running.Add(-1)
+		mu.Lock()
+		processed[uuid]++
+		mu.Unlock()
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	q.Start(ctx)
+	q.Add("job1")
+	q.Add("job2")
+	<-started
+	<-started
+	// both workers are busy, job3 waits for one of them and job1 is reconciled again once it is done
+	q.Add("job3")
+	q.Add("job1")
+	q.Add("job1")
+	release <- struct{}{}
+	release <- struct{}{}
+	<-started
+	<-started
+	release <- struct{}{}
+	release <- struct{}{}
+
+	// the workers finish their jobs before stopping
+	q.Add("job4")
+	<-started
+	cancel()
+	close(release)
+	q.Wait()
+	assert.DeepEqual(t, map[string]int{"job1": 2, "job2": 1, "job3": 1, "job4": 1}, processed)
+	assert.DeepEqual(t, int32(2), maxRunning.Load())
+}
@@ -23,6 +23,13 @@ eval $(minikube docker-env)
 kubectl apply -f mysql-deployment.yaml -n $namespace
 kubectl apply -f mysql-service.yaml -n $namespace
 kubectl apply -f minio-dev.yaml
+# the key mock TEEs sign their attestation tokens with, kept across deployments
+if ! kubectl get secret manatee-mock-attestation -n $namespace > /dev/null 2>&1; then
+    openssl genrsa -out /tmp/manatee-mock-attestation.pem 2048
+    kubectl create secret generic manatee-mock-attestation -n $namespace \
+        --from-file=key.pem=/tmp/manatee-mock-attestation.pem
+    rm -f /tmp/manatee-mock-attestation.pem
+fi
 # deploy dcr api
 helm upgrade --cleanup-on-fail \
     --set apiImage.repository=docker.io/library/api \
@@ -41,6 +48,7 @@ helm upgrade --cleanup-on-fail \
     --set config.region=${region} \
     --set config.debug=true \
     --set config.teeBackend=MOCK \
+    --set config.attestationMockEnabled=true \
     --set config.registryType=MINIKUBE \
     --set config.storageType=MINIO \
     --set config.minioSecretKey=minioadmin \
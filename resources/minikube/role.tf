@@ -25,6 +25,13 @@ resource "kubernetes_role" "role" {
     resources  = ["jobs", "pods", "pods/log"]
     verbs      = ["get", "list", "watch", "create", "update", "patch", "delete"]
   }
+
+  # leader election of the reconciler replicas
+  rule {
+    api_groups = ["coordination.k8s.io"]
+    resources  = ["leases"]
+    verbs      = ["get", "create", "update"]
+  }
 }
 
 resource "kubernetes_role_binding" "role_binding" {